@@ -27,10 +27,14 @@ type BacktestRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StartDate      string   `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate        string   `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	InitialCapital float64  `protobuf:"fixed64,3,opt,name=initial_capital,json=initialCapital,proto3" json:"initial_capital,omitempty"`
-	Symbols        []string `protobuf:"bytes,4,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	StartDate          string            `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate            string            `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	InitialCapital     float64           `protobuf:"fixed64,3,opt,name=initial_capital,json=initialCapital,proto3" json:"initial_capital,omitempty"`
+	Symbols            []string          `protobuf:"bytes,4,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	StrategyName       string            `protobuf:"bytes,5,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"` // Defaults to "momentum"
+	StrategyParameters map[string]string `protobuf:"bytes,6,rep,name=strategy_parameters,json=strategyParameters,proto3" json:"strategy_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MarketIndex        string            `protobuf:"bytes,7,opt,name=market_index,json=marketIndex,proto3" json:"market_index,omitempty"`
+	WarmupDays         int32             `protobuf:"varint,8,opt,name=warmup_days,json=warmupDays,proto3" json:"warmup_days,omitempty"` // Calendar days of history loaded before start_date for indicator lookbacks, default 400
 }
 
 func (x *BacktestRequest) Reset() {
@@ -93,6 +97,34 @@ func (x *BacktestRequest) GetSymbols() []string {
 	return nil
 }
 
+func (x *BacktestRequest) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *BacktestRequest) GetStrategyParameters() map[string]string {
+	if x != nil {
+		return x.StrategyParameters
+	}
+	return nil
+}
+
+func (x *BacktestRequest) GetMarketIndex() string {
+	if x != nil {
+		return x.MarketIndex
+	}
+	return ""
+}
+
+func (x *BacktestRequest) GetWarmupDays() int32 {
+	if x != nil {
+		return x.WarmupDays
+	}
+	return 0
+}
+
 type BacktestResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -105,6 +137,9 @@ type BacktestResult struct {
 	SharpeRatio         float64         `protobuf:"fixed64,5,opt,name=sharpe_ratio,json=sharpeRatio,proto3" json:"sharpe_ratio,omitempty"`
 	MaxDrawdown         float64         `protobuf:"fixed64,6,opt,name=max_drawdown,json=maxDrawdown,proto3" json:"max_drawdown,omitempty"`
 	Trades              []*TradeRecord  `protobuf:"bytes,7,rep,name=trades,proto3" json:"trades,omitempty"`
+	StrategyName        string          `protobuf:"bytes,8,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
+	Cagr                float64         `protobuf:"fixed64,9,opt,name=cagr,proto3" json:"cagr,omitempty"`
+	EquityCurve         []*EquityPoint  `protobuf:"bytes,10,rep,name=equity_curve,json=equityCurve,proto3" json:"equity_curve,omitempty"`
 }
 
 func (x *BacktestResult) Reset() {
@@ -188,6 +223,27 @@ func (x *BacktestResult) GetTrades() []*TradeRecord {
 	return nil
 }
 
+func (x *BacktestResult) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *BacktestResult) GetCagr() float64 {
+	if x != nil {
+		return x.Cagr
+	}
+	return 0
+}
+
+func (x *BacktestResult) GetEquityCurve() []*EquityPoint {
+	if x != nil {
+		return x.EquityCurve
+	}
+	return nil
+}
+
 type TradeRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -267,6 +323,61 @@ func (x *TradeRecord) GetPrice() float64 {
 	return 0
 }
 
+type EquityPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date  string  `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *EquityPoint) Reset() {
+	*x = EquityPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EquityPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquityPoint) ProtoMessage() {}
+
+func (x *EquityPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquityPoint.ProtoReflect.Descriptor instead.
+func (*EquityPoint) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EquityPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *EquityPoint) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
 type BacktestStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -278,7 +389,7 @@ type BacktestStatusRequest struct {
 func (x *BacktestStatusRequest) Reset() {
 	*x = BacktestStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_backtesting_service_proto_msgTypes[3]
+		mi := &file_backtesting_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -291,7 +402,7 @@ func (x *BacktestStatusRequest) String() string {
 func (*BacktestStatusRequest) ProtoMessage() {}
 
 func (x *BacktestStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backtesting_service_proto_msgTypes[3]
+	mi := &file_backtesting_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +415,7 @@ func (x *BacktestStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BacktestStatusRequest.ProtoReflect.Descriptor instead.
 func (*BacktestStatusRequest) Descriptor() ([]byte, []int) {
-	return file_backtesting_service_proto_rawDescGZIP(), []int{3}
+	return file_backtesting_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BacktestStatusRequest) GetBacktestId() string {
@@ -322,12 +433,13 @@ type BacktestStatus struct {
 	BacktestId string  `protobuf:"bytes,1,opt,name=backtest_id,json=backtestId,proto3" json:"backtest_id,omitempty"`
 	Status     string  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	Progress   float64 `protobuf:"fixed64,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	Error      string  `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (x *BacktestStatus) Reset() {
 	*x = BacktestStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_backtesting_service_proto_msgTypes[4]
+		mi := &file_backtesting_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -340,7 +452,7 @@ func (x *BacktestStatus) String() string {
 func (*BacktestStatus) ProtoMessage() {}
 
 func (x *BacktestStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_backtesting_service_proto_msgTypes[4]
+	mi := &file_backtesting_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +465,7 @@ func (x *BacktestStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BacktestStatus.ProtoReflect.Descriptor instead.
 func (*BacktestStatus) Descriptor() ([]byte, []int) {
-	return file_backtesting_service_proto_rawDescGZIP(), []int{4}
+	return file_backtesting_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BacktestStatus) GetBacktestId() string {
@@ -377,170 +489,1771 @@ func (x *BacktestStatus) GetProgress() float64 {
 	return 0
 }
 
-var File_backtesting_service_proto protoreflect.FileDescriptor
+func (x *BacktestStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
 
-var file_backtesting_service_proto_rawDesc = []byte{
-	0x0a, 0x19, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x62, 0x61, 0x63,
-	0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22,
-	0x8e, 0x01, 0x0a, 0x0f, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43,
-	0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
-	0x22, 0xc3, 0x02, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x32, 0x0a, 0x15, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f,
-	0x6c, 0x69, 0x6f, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x70,
-	0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73,
-	0x68, 0x61, 0x72, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61,
-	0x78, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x72, 0x61, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x37, 0x0a,
-	0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x38, 0x0a, 0x15,
-	0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b,
-	0x74, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x63, 0x6b,
-	0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
-	0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x32, 0xd4, 0x01,
-	0x0a, 0x12, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x74,
-	0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61,
-	0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x64,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x29, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x75, 0x6d,
-	0x2d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x61, 0x63, 0x6b,
-	0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// ParameterRange is one dimension of an optimization search space. Numeric ranges default to the
+// bounds in the strategy's parameter schema.
+type ParameterRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Min    float64  `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max    float64  `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	Step   float64  `protobuf:"fixed64,4,opt,name=step,proto3" json:"step,omitempty"`   // Grid spacing; 0 splits the range into five points
+	Values []string `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"` // Explicit candidates, required for string parameters
 }
 
-var (
-	file_backtesting_service_proto_rawDescOnce sync.Once
-	file_backtesting_service_proto_rawDescData = file_backtesting_service_proto_rawDesc
-)
+func (x *ParameterRange) Reset() {
+	*x = ParameterRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_backtesting_service_proto_rawDescGZIP() []byte {
-	file_backtesting_service_proto_rawDescOnce.Do(func() {
-		file_backtesting_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_backtesting_service_proto_rawDescData)
-	})
-	return file_backtesting_service_proto_rawDescData
+func (x *ParameterRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_backtesting_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_backtesting_service_proto_goTypes = []any{
-	(*BacktestRequest)(nil),       // 0: backtestingservice.BacktestRequest
-	(*BacktestResult)(nil),        // 1: backtestingservice.BacktestResult
-	(*TradeRecord)(nil),           // 2: backtestingservice.TradeRecord
-	(*BacktestStatusRequest)(nil), // 3: backtestingservice.BacktestStatusRequest
-	(*BacktestStatus)(nil),        // 4: backtestingservice.BacktestStatus
+func (*ParameterRange) ProtoMessage() {}
+
+func (x *ParameterRange) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_backtesting_service_proto_depIdxs = []int32{
-	4, // 0: backtestingservice.BacktestResult.status:type_name -> backtestingservice.BacktestStatus
-	2, // 1: backtestingservice.BacktestResult.trades:type_name -> backtestingservice.TradeRecord
-	0, // 2: backtestingservice.BacktestingService.RunBacktest:input_type -> backtestingservice.BacktestRequest
-	3, // 3: backtestingservice.BacktestingService.GetBacktestStatus:input_type -> backtestingservice.BacktestStatusRequest
-	1, // 4: backtestingservice.BacktestingService.RunBacktest:output_type -> backtestingservice.BacktestResult
-	4, // 5: backtestingservice.BacktestingService.GetBacktestStatus:output_type -> backtestingservice.BacktestStatus
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+// Deprecated: Use ParameterRange.ProtoReflect.Descriptor instead.
+func (*ParameterRange) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{6}
 }
 
-func init() { file_backtesting_service_proto_init() }
-func file_backtesting_service_proto_init() {
-	if File_backtesting_service_proto != nil {
-		return
+func (x *ParameterRange) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_backtesting_service_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*BacktestRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_backtesting_service_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*BacktestResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_backtesting_service_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*TradeRecord); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_backtesting_service_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*BacktestStatusRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *ParameterRange) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *ParameterRange) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ParameterRange) GetStep() float64 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *ParameterRange) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type OptimizationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Backtest       *BacktestRequest  `protobuf:"bytes,1,opt,name=backtest,proto3" json:"backtest,omitempty"` // Period, universe, strategy and the parameters that stay fixed
+	Parameters     []*ParameterRange `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	Method         string            `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`       // grid, random or bayesian
+	Objective      string            `protobuf:"bytes,4,opt,name=objective,proto3" json:"objective,omitempty"` // sharpe or cagr_maxdd
+	MaxEvaluations int32             `protobuf:"varint,5,opt,name=max_evaluations,json=maxEvaluations,proto3" json:"max_evaluations,omitempty"`
+	Parallelism    int32             `protobuf:"varint,6,opt,name=parallelism,proto3" json:"parallelism,omitempty"` // Concurrent backtests, defaults to the number of CPUs
+	Seed           int64             `protobuf:"varint,7,opt,name=seed,proto3" json:"seed,omitempty"`               // Seed for random and bayesian sampling
+}
+
+func (x *OptimizationRequest) Reset() {
+	*x = OptimizationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizationRequest) ProtoMessage() {}
+
+func (x *OptimizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_backtesting_service_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*BacktestStatus); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizationRequest.ProtoReflect.Descriptor instead.
+func (*OptimizationRequest) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OptimizationRequest) GetBacktest() *BacktestRequest {
+	if x != nil {
+		return x.Backtest
+	}
+	return nil
+}
+
+func (x *OptimizationRequest) GetParameters() []*ParameterRange {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *OptimizationRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *OptimizationRequest) GetObjective() string {
+	if x != nil {
+		return x.Objective
+	}
+	return ""
+}
+
+func (x *OptimizationRequest) GetMaxEvaluations() int32 {
+	if x != nil {
+		return x.MaxEvaluations
+	}
+	return 0
+}
+
+func (x *OptimizationRequest) GetParallelism() int32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *OptimizationRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type OptimizationStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OptimizationId  string  `protobuf:"bytes,1,opt,name=optimization_id,json=optimizationId,proto3" json:"optimization_id,omitempty"`
+	Status          string  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Progress        float64 `protobuf:"fixed64,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	CompletedTrials int32   `protobuf:"varint,4,opt,name=completed_trials,json=completedTrials,proto3" json:"completed_trials,omitempty"`
+	TotalTrials     int32   `protobuf:"varint,5,opt,name=total_trials,json=totalTrials,proto3" json:"total_trials,omitempty"`
+	Error           string  `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *OptimizationStatus) Reset() {
+	*x = OptimizationStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizationStatus) ProtoMessage() {}
+
+func (x *OptimizationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizationStatus.ProtoReflect.Descriptor instead.
+func (*OptimizationStatus) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OptimizationStatus) GetOptimizationId() string {
+	if x != nil {
+		return x.OptimizationId
+	}
+	return ""
+}
+
+func (x *OptimizationStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OptimizationStatus) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *OptimizationStatus) GetCompletedTrials() int32 {
+	if x != nil {
+		return x.CompletedTrials
+	}
+	return 0
+}
+
+func (x *OptimizationStatus) GetTotalTrials() int32 {
+	if x != nil {
+		return x.TotalTrials
+	}
+	return 0
+}
+
+func (x *OptimizationStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type OptimizationResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OptimizationId string `protobuf:"bytes,1,opt,name=optimization_id,json=optimizationId,proto3" json:"optimization_id,omitempty"`
+}
+
+func (x *OptimizationResultRequest) Reset() {
+	*x = OptimizationResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizationResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizationResultRequest) ProtoMessage() {}
+
+func (x *OptimizationResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizationResultRequest.ProtoReflect.Descriptor instead.
+func (*OptimizationResultRequest) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OptimizationResultRequest) GetOptimizationId() string {
+	if x != nil {
+		return x.OptimizationId
+	}
+	return ""
+}
+
+type OptimizationTrial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trial       int32             `protobuf:"varint,1,opt,name=trial,proto3" json:"trial,omitempty"`
+	Parameters  map[string]string `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Objective   float64           `protobuf:"fixed64,3,opt,name=objective,proto3" json:"objective,omitempty"`
+	SharpeRatio float64           `protobuf:"fixed64,4,opt,name=sharpe_ratio,json=sharpeRatio,proto3" json:"sharpe_ratio,omitempty"`
+	Cagr        float64           `protobuf:"fixed64,5,opt,name=cagr,proto3" json:"cagr,omitempty"`
+	MaxDrawdown float64           `protobuf:"fixed64,6,opt,name=max_drawdown,json=maxDrawdown,proto3" json:"max_drawdown,omitempty"`
+	TotalReturn float64           `protobuf:"fixed64,7,opt,name=total_return,json=totalReturn,proto3" json:"total_return,omitempty"`
+	Error       string            `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *OptimizationTrial) Reset() {
+	*x = OptimizationTrial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizationTrial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizationTrial) ProtoMessage() {}
+
+func (x *OptimizationTrial) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizationTrial.ProtoReflect.Descriptor instead.
+func (*OptimizationTrial) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OptimizationTrial) GetTrial() int32 {
+	if x != nil {
+		return x.Trial
+	}
+	return 0
+}
+
+func (x *OptimizationTrial) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *OptimizationTrial) GetObjective() float64 {
+	if x != nil {
+		return x.Objective
+	}
+	return 0
+}
+
+func (x *OptimizationTrial) GetSharpeRatio() float64 {
+	if x != nil {
+		return x.SharpeRatio
+	}
+	return 0
+}
+
+func (x *OptimizationTrial) GetCagr() float64 {
+	if x != nil {
+		return x.Cagr
+	}
+	return 0
+}
+
+func (x *OptimizationTrial) GetMaxDrawdown() float64 {
+	if x != nil {
+		return x.MaxDrawdown
+	}
+	return 0
+}
+
+func (x *OptimizationTrial) GetTotalReturn() float64 {
+	if x != nil {
+		return x.TotalReturn
+	}
+	return 0
+}
+
+func (x *OptimizationTrial) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// OptimizationResult holds every evaluated trial ordered by objective, best first.
+type OptimizationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OptimizationId string               `protobuf:"bytes,1,opt,name=optimization_id,json=optimizationId,proto3" json:"optimization_id,omitempty"`
+	Status         *OptimizationStatus  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	StrategyName   string               `protobuf:"bytes,3,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
+	Method         string               `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Objective      string               `protobuf:"bytes,5,opt,name=objective,proto3" json:"objective,omitempty"`
+	BestParameters map[string]string    `protobuf:"bytes,6,rep,name=best_parameters,json=bestParameters,proto3" json:"best_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BestObjective  float64              `protobuf:"fixed64,7,opt,name=best_objective,json=bestObjective,proto3" json:"best_objective,omitempty"`
+	Trials         []*OptimizationTrial `protobuf:"bytes,8,rep,name=trials,proto3" json:"trials,omitempty"`
+}
+
+func (x *OptimizationResult) Reset() {
+	*x = OptimizationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizationResult) ProtoMessage() {}
+
+func (x *OptimizationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizationResult.ProtoReflect.Descriptor instead.
+func (*OptimizationResult) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *OptimizationResult) GetOptimizationId() string {
+	if x != nil {
+		return x.OptimizationId
+	}
+	return ""
+}
+
+func (x *OptimizationResult) GetStatus() *OptimizationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *OptimizationResult) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *OptimizationResult) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *OptimizationResult) GetObjective() string {
+	if x != nil {
+		return x.Objective
+	}
+	return ""
+}
+
+func (x *OptimizationResult) GetBestParameters() map[string]string {
+	if x != nil {
+		return x.BestParameters
+	}
+	return nil
+}
+
+func (x *OptimizationResult) GetBestObjective() float64 {
+	if x != nil {
+		return x.BestObjective
+	}
+	return 0
+}
+
+func (x *OptimizationResult) GetTrials() []*OptimizationTrial {
+	if x != nil {
+		return x.Trials
+	}
+	return nil
+}
+
+// WalkForwardRequest optimizes on rolling in-sample windows and backtests the chosen parameters on
+// the out-of-sample window that follows each one. The out-of-sample windows are contiguous, so the
+// first starts in_sample_days after the backtest start date.
+type WalkForwardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Optimization    *OptimizationRequest `protobuf:"bytes,1,opt,name=optimization,proto3" json:"optimization,omitempty"`                                   // Whole analysis period and the in-sample search
+	InSampleDays    int32                `protobuf:"varint,2,opt,name=in_sample_days,json=inSampleDays,proto3" json:"in_sample_days,omitempty"`            // Calendar days, default 730
+	OutOfSampleDays int32                `protobuf:"varint,3,opt,name=out_of_sample_days,json=outOfSampleDays,proto3" json:"out_of_sample_days,omitempty"` // Calendar days, default 182
+	Anchored        bool                 `protobuf:"varint,4,opt,name=anchored,proto3" json:"anchored,omitempty"`                                          // In-sample windows all start at start_date instead of rolling
+}
+
+func (x *WalkForwardRequest) Reset() {
+	*x = WalkForwardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalkForwardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkForwardRequest) ProtoMessage() {}
+
+func (x *WalkForwardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalkForwardRequest.ProtoReflect.Descriptor instead.
+func (*WalkForwardRequest) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WalkForwardRequest) GetOptimization() *OptimizationRequest {
+	if x != nil {
+		return x.Optimization
+	}
+	return nil
+}
+
+func (x *WalkForwardRequest) GetInSampleDays() int32 {
+	if x != nil {
+		return x.InSampleDays
+	}
+	return 0
+}
+
+func (x *WalkForwardRequest) GetOutOfSampleDays() int32 {
+	if x != nil {
+		return x.OutOfSampleDays
+	}
+	return 0
+}
+
+func (x *WalkForwardRequest) GetAnchored() bool {
+	if x != nil {
+		return x.Anchored
+	}
+	return false
+}
+
+type WalkForwardStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalkForwardId    string  `protobuf:"bytes,1,opt,name=walk_forward_id,json=walkForwardId,proto3" json:"walk_forward_id,omitempty"`
+	Status           string  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Progress         float64 `protobuf:"fixed64,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	CompletedWindows int32   `protobuf:"varint,4,opt,name=completed_windows,json=completedWindows,proto3" json:"completed_windows,omitempty"`
+	TotalWindows     int32   `protobuf:"varint,5,opt,name=total_windows,json=totalWindows,proto3" json:"total_windows,omitempty"`
+	Error            string  `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *WalkForwardStatus) Reset() {
+	*x = WalkForwardStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalkForwardStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkForwardStatus) ProtoMessage() {}
+
+func (x *WalkForwardStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalkForwardStatus.ProtoReflect.Descriptor instead.
+func (*WalkForwardStatus) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WalkForwardStatus) GetWalkForwardId() string {
+	if x != nil {
+		return x.WalkForwardId
+	}
+	return ""
+}
+
+func (x *WalkForwardStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WalkForwardStatus) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *WalkForwardStatus) GetCompletedWindows() int32 {
+	if x != nil {
+		return x.CompletedWindows
+	}
+	return 0
+}
+
+func (x *WalkForwardStatus) GetTotalWindows() int32 {
+	if x != nil {
+		return x.TotalWindows
+	}
+	return 0
+}
+
+func (x *WalkForwardStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type WalkForwardResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalkForwardId string `protobuf:"bytes,1,opt,name=walk_forward_id,json=walkForwardId,proto3" json:"walk_forward_id,omitempty"`
+}
+
+func (x *WalkForwardResultRequest) Reset() {
+	*x = WalkForwardResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalkForwardResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkForwardResultRequest) ProtoMessage() {}
+
+func (x *WalkForwardResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalkForwardResultRequest.ProtoReflect.Descriptor instead.
+func (*WalkForwardResultRequest) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WalkForwardResultRequest) GetWalkForwardId() string {
+	if x != nil {
+		return x.WalkForwardId
+	}
+	return ""
+}
+
+type WalkForwardWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window                 int32             `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	InSampleStart          string            `protobuf:"bytes,2,opt,name=in_sample_start,json=inSampleStart,proto3" json:"in_sample_start,omitempty"`
+	InSampleEnd            string            `protobuf:"bytes,3,opt,name=in_sample_end,json=inSampleEnd,proto3" json:"in_sample_end,omitempty"`
+	OutOfSampleStart       string            `protobuf:"bytes,4,opt,name=out_of_sample_start,json=outOfSampleStart,proto3" json:"out_of_sample_start,omitempty"`
+	OutOfSampleEnd         string            `protobuf:"bytes,5,opt,name=out_of_sample_end,json=outOfSampleEnd,proto3" json:"out_of_sample_end,omitempty"`
+	Parameters             map[string]string `protobuf:"bytes,6,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Best in-sample parameters
+	InSampleObjective      float64           `protobuf:"fixed64,7,opt,name=in_sample_objective,json=inSampleObjective,proto3" json:"in_sample_objective,omitempty"`
+	OutOfSampleObjective   float64           `protobuf:"fixed64,8,opt,name=out_of_sample_objective,json=outOfSampleObjective,proto3" json:"out_of_sample_objective,omitempty"`
+	OutOfSampleReturn      float64           `protobuf:"fixed64,9,opt,name=out_of_sample_return,json=outOfSampleReturn,proto3" json:"out_of_sample_return,omitempty"`
+	OutOfSampleSharpeRatio float64           `protobuf:"fixed64,10,opt,name=out_of_sample_sharpe_ratio,json=outOfSampleSharpeRatio,proto3" json:"out_of_sample_sharpe_ratio,omitempty"`
+	OutOfSampleMaxDrawdown float64           `protobuf:"fixed64,11,opt,name=out_of_sample_max_drawdown,json=outOfSampleMaxDrawdown,proto3" json:"out_of_sample_max_drawdown,omitempty"`
+	Error                  string            `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *WalkForwardWindow) Reset() {
+	*x = WalkForwardWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalkForwardWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkForwardWindow) ProtoMessage() {}
+
+func (x *WalkForwardWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalkForwardWindow.ProtoReflect.Descriptor instead.
+func (*WalkForwardWindow) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WalkForwardWindow) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *WalkForwardWindow) GetInSampleStart() string {
+	if x != nil {
+		return x.InSampleStart
+	}
+	return ""
+}
+
+func (x *WalkForwardWindow) GetInSampleEnd() string {
+	if x != nil {
+		return x.InSampleEnd
+	}
+	return ""
+}
+
+func (x *WalkForwardWindow) GetOutOfSampleStart() string {
+	if x != nil {
+		return x.OutOfSampleStart
+	}
+	return ""
+}
+
+func (x *WalkForwardWindow) GetOutOfSampleEnd() string {
+	if x != nil {
+		return x.OutOfSampleEnd
+	}
+	return ""
+}
+
+func (x *WalkForwardWindow) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *WalkForwardWindow) GetInSampleObjective() float64 {
+	if x != nil {
+		return x.InSampleObjective
+	}
+	return 0
+}
+
+func (x *WalkForwardWindow) GetOutOfSampleObjective() float64 {
+	if x != nil {
+		return x.OutOfSampleObjective
+	}
+	return 0
+}
+
+func (x *WalkForwardWindow) GetOutOfSampleReturn() float64 {
+	if x != nil {
+		return x.OutOfSampleReturn
+	}
+	return 0
+}
+
+func (x *WalkForwardWindow) GetOutOfSampleSharpeRatio() float64 {
+	if x != nil {
+		return x.OutOfSampleSharpeRatio
+	}
+	return 0
+}
+
+func (x *WalkForwardWindow) GetOutOfSampleMaxDrawdown() float64 {
+	if x != nil {
+		return x.OutOfSampleMaxDrawdown
+	}
+	return 0
+}
+
+func (x *WalkForwardWindow) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ParameterStability summarizes the values one parameter took across the windows.
+type ParameterStability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values                 []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"` // Chosen value per successful window
+	DistinctValues         int32    `protobuf:"varint,3,opt,name=distinct_values,json=distinctValues,proto3" json:"distinct_values,omitempty"`
+	MostFrequent           string   `protobuf:"bytes,4,opt,name=most_frequent,json=mostFrequent,proto3" json:"most_frequent,omitempty"`
+	MostFrequentShare      float64  `protobuf:"fixed64,5,opt,name=most_frequent_share,json=mostFrequentShare,proto3" json:"most_frequent_share,omitempty"`
+	Mean                   float64  `protobuf:"fixed64,6,opt,name=mean,proto3" json:"mean,omitempty"` // Numeric parameters only
+	StdDev                 float64  `protobuf:"fixed64,7,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	CoefficientOfVariation float64  `protobuf:"fixed64,8,opt,name=coefficient_of_variation,json=coefficientOfVariation,proto3" json:"coefficient_of_variation,omitempty"`
+}
+
+func (x *ParameterStability) Reset() {
+	*x = ParameterStability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParameterStability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterStability) ProtoMessage() {}
+
+func (x *ParameterStability) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterStability.ProtoReflect.Descriptor instead.
+func (*ParameterStability) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ParameterStability) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParameterStability) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ParameterStability) GetDistinctValues() int32 {
+	if x != nil {
+		return x.DistinctValues
+	}
+	return 0
+}
+
+func (x *ParameterStability) GetMostFrequent() string {
+	if x != nil {
+		return x.MostFrequent
+	}
+	return ""
+}
+
+func (x *ParameterStability) GetMostFrequentShare() float64 {
+	if x != nil {
+		return x.MostFrequentShare
+	}
+	return 0
+}
+
+func (x *ParameterStability) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *ParameterStability) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
+}
+
+func (x *ParameterStability) GetCoefficientOfVariation() float64 {
+	if x != nil {
+		return x.CoefficientOfVariation
+	}
+	return 0
+}
+
+type WalkForwardResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalkForwardId       string                `protobuf:"bytes,1,opt,name=walk_forward_id,json=walkForwardId,proto3" json:"walk_forward_id,omitempty"`
+	Status              *WalkForwardStatus    `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	StrategyName        string                `protobuf:"bytes,3,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
+	Objective           string                `protobuf:"bytes,4,opt,name=objective,proto3" json:"objective,omitempty"`
+	Windows             []*WalkForwardWindow  `protobuf:"bytes,5,rep,name=windows,proto3" json:"windows,omitempty"`
+	EquityCurve         []*EquityPoint        `protobuf:"bytes,6,rep,name=equity_curve,json=equityCurve,proto3" json:"equity_curve,omitempty"` // Out-of-sample windows stitched together
+	FinalPortfolioValue float64               `protobuf:"fixed64,7,opt,name=final_portfolio_value,json=finalPortfolioValue,proto3" json:"final_portfolio_value,omitempty"`
+	TotalReturn         float64               `protobuf:"fixed64,8,opt,name=total_return,json=totalReturn,proto3" json:"total_return,omitempty"`
+	Cagr                float64               `protobuf:"fixed64,9,opt,name=cagr,proto3" json:"cagr,omitempty"`
+	SharpeRatio         float64               `protobuf:"fixed64,10,opt,name=sharpe_ratio,json=sharpeRatio,proto3" json:"sharpe_ratio,omitempty"`
+	MaxDrawdown         float64               `protobuf:"fixed64,11,opt,name=max_drawdown,json=maxDrawdown,proto3" json:"max_drawdown,omitempty"`
+	Efficiency          float64               `protobuf:"fixed64,12,opt,name=efficiency,proto3" json:"efficiency,omitempty"` // Mean out-of-sample objective over mean in-sample objective, 0 when the latter is not positive
+	Stability           []*ParameterStability `protobuf:"bytes,13,rep,name=stability,proto3" json:"stability,omitempty"`
+}
+
+func (x *WalkForwardResult) Reset() {
+	*x = WalkForwardResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backtesting_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalkForwardResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkForwardResult) ProtoMessage() {}
+
+func (x *WalkForwardResult) ProtoReflect() protoreflect.Message {
+	mi := &file_backtesting_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalkForwardResult.ProtoReflect.Descriptor instead.
+func (*WalkForwardResult) Descriptor() ([]byte, []int) {
+	return file_backtesting_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WalkForwardResult) GetWalkForwardId() string {
+	if x != nil {
+		return x.WalkForwardId
+	}
+	return ""
+}
+
+func (x *WalkForwardResult) GetStatus() *WalkForwardStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *WalkForwardResult) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *WalkForwardResult) GetObjective() string {
+	if x != nil {
+		return x.Objective
+	}
+	return ""
+}
+
+func (x *WalkForwardResult) GetWindows() []*WalkForwardWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *WalkForwardResult) GetEquityCurve() []*EquityPoint {
+	if x != nil {
+		return x.EquityCurve
+	}
+	return nil
+}
+
+func (x *WalkForwardResult) GetFinalPortfolioValue() float64 {
+	if x != nil {
+		return x.FinalPortfolioValue
+	}
+	return 0
+}
+
+func (x *WalkForwardResult) GetTotalReturn() float64 {
+	if x != nil {
+		return x.TotalReturn
+	}
+	return 0
+}
+
+func (x *WalkForwardResult) GetCagr() float64 {
+	if x != nil {
+		return x.Cagr
+	}
+	return 0
+}
+
+func (x *WalkForwardResult) GetSharpeRatio() float64 {
+	if x != nil {
+		return x.SharpeRatio
+	}
+	return 0
+}
+
+func (x *WalkForwardResult) GetMaxDrawdown() float64 {
+	if x != nil {
+		return x.MaxDrawdown
+	}
+	return 0
+}
+
+func (x *WalkForwardResult) GetEfficiency() float64 {
+	if x != nil {
+		return x.Efficiency
+	}
+	return 0
+}
+
+func (x *WalkForwardResult) GetStability() []*ParameterStability {
+	if x != nil {
+		return x.Stability
+	}
+	return nil
+}
+
+var File_backtesting_service_proto protoreflect.FileDescriptor
+
+var file_backtesting_service_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x62, 0x61, 0x63,
+	0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22,
+	0xac, 0x03, 0x0a, 0x0f, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43,
+	0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x6c, 0x0a, 0x13, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x12, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x72, 0x6d, 0x75, 0x70,
+	0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x61, 0x72,
+	0x6d, 0x75, 0x70, 0x44, 0x61, 0x79, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc0,
+	0x03, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
+	0x6f, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x70, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x68, 0x61,
+	0x72, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f,
+	0x64, 0x72, 0x61, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x44, 0x72, 0x61, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61,
+	0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x67,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x61, 0x67, 0x72, 0x12, 0x42, 0x0a,
+	0x0c, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x74, 0x79, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x43, 0x75, 0x72, 0x76,
+	0x65, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x37, 0x0a, 0x0b, 0x45, 0x71, 0x75, 0x69, 0x74,
+	0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x38, 0x0a, 0x15, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x63,
+	0x6b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x0e, 0x42, 0x61,
+	0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x74, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xaf, 0x02,
+	0x0a, 0x13, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63,
+	0x6b, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x62, 0x61,
+	0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x61, 0x63,
+	0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72,
+	0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22,
+	0xd5, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x69, 0x61, 0x6c,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x44, 0x0a, 0x19, 0x4f, 0x70, 0x74, 0x69, 0x6d,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f,
+	0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xf0, 0x02,
+	0x0a, 0x11, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72,
+	0x69, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x55, 0x0a, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x72, 0x69, 0x61, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x70, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69,
+	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x67, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x63, 0x61, 0x67, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x72, 0x61,
+	0x77, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x44, 0x72, 0x61, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xe6, 0x03, 0x0a, 0x12, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6d,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x63, 0x0a, 0x0f, 0x62,
+	0x65, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x42, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0e, 0x62, 0x65, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x62, 0x65, 0x73, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x74, 0x72, 0x69, 0x61, 0x6c,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x06,
+	0x74, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x42, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x01, 0x0a, 0x12, 0x57, 0x61,
+	0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x4b, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+	0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a,
+	0x0e, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x44,
+	0x61, 0x79, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x6f, 0x75, 0x74, 0x4f, 0x66, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x44, 0x61, 0x79, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x22, 0xd7, 0x01, 0x0a,
+	0x11, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x6c, 0x6b, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c,
+	0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x18, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x6c, 0x6b, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c,
+	0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x8d, 0x05, 0x0a, 0x11, 0x57,
+	0x61, 0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x5f, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x69, 0x6e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x22, 0x0a, 0x0d, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x65, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x45, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x4f, 0x66, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6f, 0x75, 0x74, 0x4f, 0x66, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x55,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x11, 0x69, 0x6e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x6f, 0x75, 0x74, 0x4f, 0x66, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2f, 0x0a, 0x14,
+	0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x4f,
+	0x66, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x3a, 0x0a,
+	0x1a, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x70, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x16, 0x6f, 0x75, 0x74, 0x4f, 0x66, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x68,
+	0x61, 0x72, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x3a, 0x0a, 0x1a, 0x6f, 0x75, 0x74,
+	0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x64,
+	0x72, 0x61, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x6f,
+	0x75, 0x74, 0x4f, 0x66, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x61, 0x78, 0x44, 0x72, 0x61,
+	0x77, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x3d, 0x0a, 0x0f, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa5, 0x02, 0x0a, 0x12, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6f, 0x73, 0x74, 0x5f, 0x66,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d,
+	0x6f, 0x73, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
+	0x6f, 0x73, 0x74, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x6f, 0x73, 0x74, 0x46, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x65, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x73, 0x74, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x73, 0x74, 0x64, 0x44, 0x65, 0x76, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x6f, 0x65, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x63, 0x6f, 0x65, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xd9, 0x04, 0x0a, 0x11, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x61, 0x6c, 0x6b,
+	0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64,
+	0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x75,
+	0x72, 0x76, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x63, 0x6b,
+	0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
+	0x71, 0x75, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x71, 0x75, 0x69,
+	0x74, 0x79, 0x43, 0x75, 0x72, 0x76, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x61, 0x67, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x61,
+	0x67, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x70, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x70, 0x65,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x72, 0x61,
+	0x77, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x44, 0x72, 0x61, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x66, 0x66, 0x69,
+	0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x61,
+	0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x09, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x32, 0xe4,
+	0x05, 0x0a, 0x12, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x42, 0x61, 0x63, 0x6b,
+	0x74, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x61, 0x63, 0x6b,
+	0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42,
+	0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12,
+	0x64, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b,
+	0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x2e, 0x62, 0x61, 0x63,
+	0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x74,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0f, 0x52,
+	0x75, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27,
+	0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x00, 0x12, 0x70, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2d, 0x2e, 0x62, 0x61, 0x63,
+	0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61, 0x63, 0x6b,
+	0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f,
+	0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c,
+	0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2c,
+	0x2e, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x75,
+	0x6d, 0x2d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x61, 0x63,
+	0x6b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_backtesting_service_proto_rawDescOnce sync.Once
+	file_backtesting_service_proto_rawDescData = file_backtesting_service_proto_rawDesc
+)
+
+func file_backtesting_service_proto_rawDescGZIP() []byte {
+	file_backtesting_service_proto_rawDescOnce.Do(func() {
+		file_backtesting_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_backtesting_service_proto_rawDescData)
+	})
+	return file_backtesting_service_proto_rawDescData
+}
+
+var file_backtesting_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_backtesting_service_proto_goTypes = []any{
+	(*BacktestRequest)(nil),           // 0: backtestingservice.BacktestRequest
+	(*BacktestResult)(nil),            // 1: backtestingservice.BacktestResult
+	(*TradeRecord)(nil),               // 2: backtestingservice.TradeRecord
+	(*EquityPoint)(nil),               // 3: backtestingservice.EquityPoint
+	(*BacktestStatusRequest)(nil),     // 4: backtestingservice.BacktestStatusRequest
+	(*BacktestStatus)(nil),            // 5: backtestingservice.BacktestStatus
+	(*ParameterRange)(nil),            // 6: backtestingservice.ParameterRange
+	(*OptimizationRequest)(nil),       // 7: backtestingservice.OptimizationRequest
+	(*OptimizationStatus)(nil),        // 8: backtestingservice.OptimizationStatus
+	(*OptimizationResultRequest)(nil), // 9: backtestingservice.OptimizationResultRequest
+	(*OptimizationTrial)(nil),         // 10: backtestingservice.OptimizationTrial
+	(*OptimizationResult)(nil),        // 11: backtestingservice.OptimizationResult
+	(*WalkForwardRequest)(nil),        // 12: backtestingservice.WalkForwardRequest
+	(*WalkForwardStatus)(nil),         // 13: backtestingservice.WalkForwardStatus
+	(*WalkForwardResultRequest)(nil),  // 14: backtestingservice.WalkForwardResultRequest
+	(*WalkForwardWindow)(nil),         // 15: backtestingservice.WalkForwardWindow
+	(*ParameterStability)(nil),        // 16: backtestingservice.ParameterStability
+	(*WalkForwardResult)(nil),         // 17: backtestingservice.WalkForwardResult
+	nil,                               // 18: backtestingservice.BacktestRequest.StrategyParametersEntry
+	nil,                               // 19: backtestingservice.OptimizationTrial.ParametersEntry
+	nil,                               // 20: backtestingservice.OptimizationResult.BestParametersEntry
+	nil,                               // 21: backtestingservice.WalkForwardWindow.ParametersEntry
+}
+var file_backtesting_service_proto_depIdxs = []int32{
+	18, // 0: backtestingservice.BacktestRequest.strategy_parameters:type_name -> backtestingservice.BacktestRequest.StrategyParametersEntry
+	5,  // 1: backtestingservice.BacktestResult.status:type_name -> backtestingservice.BacktestStatus
+	2,  // 2: backtestingservice.BacktestResult.trades:type_name -> backtestingservice.TradeRecord
+	3,  // 3: backtestingservice.BacktestResult.equity_curve:type_name -> backtestingservice.EquityPoint
+	0,  // 4: backtestingservice.OptimizationRequest.backtest:type_name -> backtestingservice.BacktestRequest
+	6,  // 5: backtestingservice.OptimizationRequest.parameters:type_name -> backtestingservice.ParameterRange
+	19, // 6: backtestingservice.OptimizationTrial.parameters:type_name -> backtestingservice.OptimizationTrial.ParametersEntry
+	8,  // 7: backtestingservice.OptimizationResult.status:type_name -> backtestingservice.OptimizationStatus
+	20, // 8: backtestingservice.OptimizationResult.best_parameters:type_name -> backtestingservice.OptimizationResult.BestParametersEntry
+	10, // 9: backtestingservice.OptimizationResult.trials:type_name -> backtestingservice.OptimizationTrial
+	7,  // 10: backtestingservice.WalkForwardRequest.optimization:type_name -> backtestingservice.OptimizationRequest
+	21, // 11: backtestingservice.WalkForwardWindow.parameters:type_name -> backtestingservice.WalkForwardWindow.ParametersEntry
+	13, // 12: backtestingservice.WalkForwardResult.status:type_name -> backtestingservice.WalkForwardStatus
+	15, // 13: backtestingservice.WalkForwardResult.windows:type_name -> backtestingservice.WalkForwardWindow
+	3,  // 14: backtestingservice.WalkForwardResult.equity_curve:type_name -> backtestingservice.EquityPoint
+	16, // 15: backtestingservice.WalkForwardResult.stability:type_name -> backtestingservice.ParameterStability
+	0,  // 16: backtestingservice.BacktestingService.RunBacktest:input_type -> backtestingservice.BacktestRequest
+	4,  // 17: backtestingservice.BacktestingService.GetBacktestStatus:input_type -> backtestingservice.BacktestStatusRequest
+	4,  // 18: backtestingservice.BacktestingService.GetBacktestResult:input_type -> backtestingservice.BacktestStatusRequest
+	7,  // 19: backtestingservice.BacktestingService.RunOptimization:input_type -> backtestingservice.OptimizationRequest
+	9,  // 20: backtestingservice.BacktestingService.GetOptimizationResult:input_type -> backtestingservice.OptimizationResultRequest
+	12, // 21: backtestingservice.BacktestingService.RunWalkForward:input_type -> backtestingservice.WalkForwardRequest
+	14, // 22: backtestingservice.BacktestingService.GetWalkForwardResult:input_type -> backtestingservice.WalkForwardResultRequest
+	1,  // 23: backtestingservice.BacktestingService.RunBacktest:output_type -> backtestingservice.BacktestResult
+	5,  // 24: backtestingservice.BacktestingService.GetBacktestStatus:output_type -> backtestingservice.BacktestStatus
+	1,  // 25: backtestingservice.BacktestingService.GetBacktestResult:output_type -> backtestingservice.BacktestResult
+	8,  // 26: backtestingservice.BacktestingService.RunOptimization:output_type -> backtestingservice.OptimizationStatus
+	11, // 27: backtestingservice.BacktestingService.GetOptimizationResult:output_type -> backtestingservice.OptimizationResult
+	13, // 28: backtestingservice.BacktestingService.RunWalkForward:output_type -> backtestingservice.WalkForwardStatus
+	17, // 29: backtestingservice.BacktestingService.GetWalkForwardResult:output_type -> backtestingservice.WalkForwardResult
+	23, // [23:30] is the sub-list for method output_type
+	16, // [16:23] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_backtesting_service_proto_init() }
+func file_backtesting_service_proto_init() {
+	if File_backtesting_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_backtesting_service_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*BacktestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*BacktestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*TradeRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*EquityPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*BacktestStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*BacktestStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ParameterRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*OptimizationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*OptimizationStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*OptimizationResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*OptimizationTrial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*OptimizationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*WalkForwardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*WalkForwardStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*WalkForwardResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*WalkForwardWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ParameterStability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backtesting_service_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*WalkForwardResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -558,7 +2271,7 @@ func file_backtesting_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_backtesting_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
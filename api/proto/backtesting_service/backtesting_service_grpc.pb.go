@@ -21,8 +21,13 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	BacktestingService_RunBacktest_FullMethodName       = "/backtestingservice.BacktestingService/RunBacktest"
-	BacktestingService_GetBacktestStatus_FullMethodName = "/backtestingservice.BacktestingService/GetBacktestStatus"
+	BacktestingService_RunBacktest_FullMethodName           = "/backtestingservice.BacktestingService/RunBacktest"
+	BacktestingService_GetBacktestStatus_FullMethodName     = "/backtestingservice.BacktestingService/GetBacktestStatus"
+	BacktestingService_GetBacktestResult_FullMethodName     = "/backtestingservice.BacktestingService/GetBacktestResult"
+	BacktestingService_RunOptimization_FullMethodName       = "/backtestingservice.BacktestingService/RunOptimization"
+	BacktestingService_GetOptimizationResult_FullMethodName = "/backtestingservice.BacktestingService/GetOptimizationResult"
+	BacktestingService_RunWalkForward_FullMethodName        = "/backtestingservice.BacktestingService/RunWalkForward"
+	BacktestingService_GetWalkForwardResult_FullMethodName  = "/backtestingservice.BacktestingService/GetWalkForwardResult"
 )
 
 // BacktestingServiceClient is the client API for BacktestingService service.
@@ -31,6 +36,11 @@ const (
 type BacktestingServiceClient interface {
 	RunBacktest(ctx context.Context, in *BacktestRequest, opts ...grpc.CallOption) (*BacktestResult, error)
 	GetBacktestStatus(ctx context.Context, in *BacktestStatusRequest, opts ...grpc.CallOption) (*BacktestStatus, error)
+	GetBacktestResult(ctx context.Context, in *BacktestStatusRequest, opts ...grpc.CallOption) (*BacktestResult, error)
+	RunOptimization(ctx context.Context, in *OptimizationRequest, opts ...grpc.CallOption) (*OptimizationStatus, error)
+	GetOptimizationResult(ctx context.Context, in *OptimizationResultRequest, opts ...grpc.CallOption) (*OptimizationResult, error)
+	RunWalkForward(ctx context.Context, in *WalkForwardRequest, opts ...grpc.CallOption) (*WalkForwardStatus, error)
+	GetWalkForwardResult(ctx context.Context, in *WalkForwardResultRequest, opts ...grpc.CallOption) (*WalkForwardResult, error)
 }
 
 type backtestingServiceClient struct {
@@ -61,12 +71,67 @@ func (c *backtestingServiceClient) GetBacktestStatus(ctx context.Context, in *Ba
 	return out, nil
 }
 
+func (c *backtestingServiceClient) GetBacktestResult(ctx context.Context, in *BacktestStatusRequest, opts ...grpc.CallOption) (*BacktestResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BacktestResult)
+	err := c.cc.Invoke(ctx, BacktestingService_GetBacktestResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtestingServiceClient) RunOptimization(ctx context.Context, in *OptimizationRequest, opts ...grpc.CallOption) (*OptimizationStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptimizationStatus)
+	err := c.cc.Invoke(ctx, BacktestingService_RunOptimization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtestingServiceClient) GetOptimizationResult(ctx context.Context, in *OptimizationResultRequest, opts ...grpc.CallOption) (*OptimizationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptimizationResult)
+	err := c.cc.Invoke(ctx, BacktestingService_GetOptimizationResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtestingServiceClient) RunWalkForward(ctx context.Context, in *WalkForwardRequest, opts ...grpc.CallOption) (*WalkForwardStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalkForwardStatus)
+	err := c.cc.Invoke(ctx, BacktestingService_RunWalkForward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtestingServiceClient) GetWalkForwardResult(ctx context.Context, in *WalkForwardResultRequest, opts ...grpc.CallOption) (*WalkForwardResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalkForwardResult)
+	err := c.cc.Invoke(ctx, BacktestingService_GetWalkForwardResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BacktestingServiceServer is the server API for BacktestingService service.
 // All implementations must embed UnimplementedBacktestingServiceServer
 // for forward compatibility
 type BacktestingServiceServer interface {
 	RunBacktest(context.Context, *BacktestRequest) (*BacktestResult, error)
 	GetBacktestStatus(context.Context, *BacktestStatusRequest) (*BacktestStatus, error)
+	GetBacktestResult(context.Context, *BacktestStatusRequest) (*BacktestResult, error)
+	RunOptimization(context.Context, *OptimizationRequest) (*OptimizationStatus, error)
+	GetOptimizationResult(context.Context, *OptimizationResultRequest) (*OptimizationResult, error)
+	RunWalkForward(context.Context, *WalkForwardRequest) (*WalkForwardStatus, error)
+	GetWalkForwardResult(context.Context, *WalkForwardResultRequest) (*WalkForwardResult, error)
 	mustEmbedUnimplementedBacktestingServiceServer()
 }
 
@@ -80,6 +145,21 @@ func (UnimplementedBacktestingServiceServer) RunBacktest(context.Context, *Backt
 func (UnimplementedBacktestingServiceServer) GetBacktestStatus(context.Context, *BacktestStatusRequest) (*BacktestStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBacktestStatus not implemented")
 }
+func (UnimplementedBacktestingServiceServer) GetBacktestResult(context.Context, *BacktestStatusRequest) (*BacktestResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBacktestResult not implemented")
+}
+func (UnimplementedBacktestingServiceServer) RunOptimization(context.Context, *OptimizationRequest) (*OptimizationStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunOptimization not implemented")
+}
+func (UnimplementedBacktestingServiceServer) GetOptimizationResult(context.Context, *OptimizationResultRequest) (*OptimizationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOptimizationResult not implemented")
+}
+func (UnimplementedBacktestingServiceServer) RunWalkForward(context.Context, *WalkForwardRequest) (*WalkForwardStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunWalkForward not implemented")
+}
+func (UnimplementedBacktestingServiceServer) GetWalkForwardResult(context.Context, *WalkForwardResultRequest) (*WalkForwardResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWalkForwardResult not implemented")
+}
 func (UnimplementedBacktestingServiceServer) mustEmbedUnimplementedBacktestingServiceServer() {}
 
 // UnsafeBacktestingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +209,96 @@ func _BacktestingService_GetBacktestStatus_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BacktestingService_GetBacktestResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BacktestStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktestingServiceServer).GetBacktestResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktestingService_GetBacktestResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktestingServiceServer).GetBacktestResult(ctx, req.(*BacktestStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktestingService_RunOptimization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktestingServiceServer).RunOptimization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktestingService_RunOptimization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktestingServiceServer).RunOptimization(ctx, req.(*OptimizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktestingService_GetOptimizationResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimizationResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktestingServiceServer).GetOptimizationResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktestingService_GetOptimizationResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktestingServiceServer).GetOptimizationResult(ctx, req.(*OptimizationResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktestingService_RunWalkForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalkForwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktestingServiceServer).RunWalkForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktestingService_RunWalkForward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktestingServiceServer).RunWalkForward(ctx, req.(*WalkForwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktestingService_GetWalkForwardResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalkForwardResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktestingServiceServer).GetWalkForwardResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktestingService_GetWalkForwardResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktestingServiceServer).GetWalkForwardResult(ctx, req.(*WalkForwardResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BacktestingService_ServiceDesc is the grpc.ServiceDesc for BacktestingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +314,26 @@ var BacktestingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBacktestStatus",
 			Handler:    _BacktestingService_GetBacktestStatus_Handler,
 		},
+		{
+			MethodName: "GetBacktestResult",
+			Handler:    _BacktestingService_GetBacktestResult_Handler,
+		},
+		{
+			MethodName: "RunOptimization",
+			Handler:    _BacktestingService_RunOptimization_Handler,
+		},
+		{
+			MethodName: "GetOptimizationResult",
+			Handler:    _BacktestingService_GetOptimizationResult_Handler,
+		},
+		{
+			MethodName: "RunWalkForward",
+			Handler:    _BacktestingService_RunWalkForward_Handler,
+		},
+		{
+			MethodName: "GetWalkForwardResult",
+			Handler:    _BacktestingService_GetWalkForwardResult_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backtesting_service.proto",
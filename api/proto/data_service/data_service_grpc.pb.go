@@ -21,9 +21,11 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	DataService_GetStockData_FullMethodName      = "/dataservice.DataService/GetStockData"
-	DataService_GetBatchStockData_FullMethodName = "/dataservice.DataService/GetBatchStockData"
-	DataService_UpdateLatestData_FullMethodName  = "/dataservice.DataService/UpdateLatestData"
+	DataService_GetStockData_FullMethodName           = "/dataservice.DataService/GetStockData"
+	DataService_GetBatchStockData_FullMethodName      = "/dataservice.DataService/GetBatchStockData"
+	DataService_UpdateLatestData_FullMethodName       = "/dataservice.DataService/UpdateLatestData"
+	DataService_GetSecurityMetadata_FullMethodName    = "/dataservice.DataService/GetSecurityMetadata"
+	DataService_UpdateSecurityMetadata_FullMethodName = "/dataservice.DataService/UpdateSecurityMetadata"
 )
 
 // DataServiceClient is the client API for DataService service.
@@ -33,6 +35,8 @@ type DataServiceClient interface {
 	GetStockData(ctx context.Context, in *StockRequest, opts ...grpc.CallOption) (*StockResponse, error)
 	GetBatchStockData(ctx context.Context, in *BatchStockRequest, opts ...grpc.CallOption) (*BatchStockResponse, error)
 	UpdateLatestData(ctx context.Context, in *UpdateLatestDataRequest, opts ...grpc.CallOption) (*UpdateLatestDataResponse, error)
+	GetSecurityMetadata(ctx context.Context, in *SecurityMetadataRequest, opts ...grpc.CallOption) (*SecurityMetadataResponse, error)
+	UpdateSecurityMetadata(ctx context.Context, in *UpdateSecurityMetadataRequest, opts ...grpc.CallOption) (*UpdateSecurityMetadataResponse, error)
 }
 
 type dataServiceClient struct {
@@ -73,6 +77,26 @@ func (c *dataServiceClient) UpdateLatestData(ctx context.Context, in *UpdateLate
 	return out, nil
 }
 
+func (c *dataServiceClient) GetSecurityMetadata(ctx context.Context, in *SecurityMetadataRequest, opts ...grpc.CallOption) (*SecurityMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecurityMetadataResponse)
+	err := c.cc.Invoke(ctx, DataService_GetSecurityMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) UpdateSecurityMetadata(ctx context.Context, in *UpdateSecurityMetadataRequest, opts ...grpc.CallOption) (*UpdateSecurityMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSecurityMetadataResponse)
+	err := c.cc.Invoke(ctx, DataService_UpdateSecurityMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataServiceServer is the server API for DataService service.
 // All implementations must embed UnimplementedDataServiceServer
 // for forward compatibility
@@ -80,6 +104,8 @@ type DataServiceServer interface {
 	GetStockData(context.Context, *StockRequest) (*StockResponse, error)
 	GetBatchStockData(context.Context, *BatchStockRequest) (*BatchStockResponse, error)
 	UpdateLatestData(context.Context, *UpdateLatestDataRequest) (*UpdateLatestDataResponse, error)
+	GetSecurityMetadata(context.Context, *SecurityMetadataRequest) (*SecurityMetadataResponse, error)
+	UpdateSecurityMetadata(context.Context, *UpdateSecurityMetadataRequest) (*UpdateSecurityMetadataResponse, error)
 	mustEmbedUnimplementedDataServiceServer()
 }
 
@@ -96,6 +122,12 @@ func (UnimplementedDataServiceServer) GetBatchStockData(context.Context, *BatchS
 func (UnimplementedDataServiceServer) UpdateLatestData(context.Context, *UpdateLatestDataRequest) (*UpdateLatestDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLatestData not implemented")
 }
+func (UnimplementedDataServiceServer) GetSecurityMetadata(context.Context, *SecurityMetadataRequest) (*SecurityMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecurityMetadata not implemented")
+}
+func (UnimplementedDataServiceServer) UpdateSecurityMetadata(context.Context, *UpdateSecurityMetadataRequest) (*UpdateSecurityMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecurityMetadata not implemented")
+}
 func (UnimplementedDataServiceServer) mustEmbedUnimplementedDataServiceServer() {}
 
 // UnsafeDataServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -163,6 +195,42 @@ func _DataService_UpdateLatestData_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataService_GetSecurityMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecurityMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).GetSecurityMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_GetSecurityMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).GetSecurityMetadata(ctx, req.(*SecurityMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_UpdateSecurityMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSecurityMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).UpdateSecurityMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_UpdateSecurityMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).UpdateSecurityMetadata(ctx, req.(*UpdateSecurityMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DataService_ServiceDesc is the grpc.ServiceDesc for DataService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -182,6 +250,14 @@ var DataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLatestData",
 			Handler:    _DataService_UpdateLatestData_Handler,
 		},
+		{
+			MethodName: "GetSecurityMetadata",
+			Handler:    _DataService_GetSecurityMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateSecurityMetadata",
+			Handler:    _DataService_UpdateSecurityMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_service.proto",
@@ -69,12 +69,59 @@ func (OrderType) EnumDescriptor() ([]byte, []int) {
 	return file_portfolio_service_proto_rawDescGZIP(), []int{0}
 }
 
+type RiskAction int32
+
+const (
+	RiskAction_CLIP  RiskAction = 0 // Reduce the target to the limit; freed value stays in cash
+	RiskAction_BLOCK RiskAction = 1 // Submit nothing while the target breaches the limit
+)
+
+// Enum value maps for RiskAction.
+var (
+	RiskAction_name = map[int32]string{
+		0: "CLIP",
+		1: "BLOCK",
+	}
+	RiskAction_value = map[string]int32{
+		"CLIP":  0,
+		"BLOCK": 1,
+	}
+)
+
+func (x RiskAction) Enum() *RiskAction {
+	p := new(RiskAction)
+	*p = x
+	return p
+}
+
+func (x RiskAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RiskAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_portfolio_service_proto_enumTypes[1].Descriptor()
+}
+
+func (RiskAction) Type() protoreflect.EnumType {
+	return &file_portfolio_service_proto_enumTypes[1]
+}
+
+func (x RiskAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RiskAction.Descriptor instead.
+func (RiskAction) EnumDescriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{1}
+}
+
 type GenerateOrdersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signals []*strategy_service.StockSignal `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty"`
+	Signals        []*strategy_service.StockSignal `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty"`
+	TargetExposure *float64                        `protobuf:"fixed64,2,opt,name=target_exposure,json=targetExposure,proto3,oneof" json:"target_exposure,omitempty"` // Fraction of the portfolio value to invest, fully invested when unset
 }
 
 func (x *GenerateOrdersRequest) Reset() {
@@ -116,6 +163,13 @@ func (x *GenerateOrdersRequest) GetSignals() []*strategy_service.StockSignal {
 	return nil
 }
 
+func (x *GenerateOrdersRequest) GetTargetExposure() float64 {
+	if x != nil && x.TargetExposure != nil {
+		return *x.TargetExposure
+	}
+	return 0
+}
+
 type GenerateOrdersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -238,6 +292,8 @@ type GetDesiredPortfolioStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PortfolioId string `protobuf:"bytes,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"` // Portfolio to act on, the default portfolio when empty
 }
 
 func (x *GetDesiredPortfolioStateRequest) Reset() {
@@ -272,6 +328,13 @@ func (*GetDesiredPortfolioStateRequest) Descriptor() ([]byte, []int) {
 	return file_portfolio_service_proto_rawDescGZIP(), []int{3}
 }
 
+func (x *GetDesiredPortfolioStateRequest) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
 type PortfolioState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -410,6 +473,9 @@ type TriggerRebalanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DryRun      bool   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`               // Plan the rebalance and return the preview without submitting orders
+	PortfolioId string `protobuf:"bytes,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"` // Portfolio to act on, the default portfolio when empty
 }
 
 func (x *TriggerRebalanceRequest) Reset() {
@@ -444,13 +510,30 @@ func (*TriggerRebalanceRequest) Descriptor() ([]byte, []int) {
 	return file_portfolio_service_proto_rawDescGZIP(), []int{6}
 }
 
+func (x *TriggerRebalanceRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *TriggerRebalanceRequest) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
 type TriggerRebalanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success     bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message     string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Preview     *RebalancePreview `protobuf:"bytes,3,opt,name=preview,proto3" json:"preview,omitempty"`                            // What was planned, and all that is traded on a dry run
+	Phases      []*ExecutionPhase `protobuf:"bytes,4,rep,name=phases,proto3" json:"phases,omitempty"`                              // Sells, then buys sized to the cash the sells left
+	RebalanceId string            `protobuf:"bytes,5,opt,name=rebalance_id,json=rebalanceId,proto3" json:"rebalance_id,omitempty"` // Id of the stored run, empty on a dry run
 }
 
 func (x *TriggerRebalanceResponse) Reset() {
@@ -499,16 +582,45 @@ func (x *TriggerRebalanceResponse) GetMessage() string {
 	return ""
 }
 
-type UpdateRebalanceScheduleRequest struct {
+func (x *TriggerRebalanceResponse) GetPreview() *RebalancePreview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+func (x *TriggerRebalanceResponse) GetPhases() []*ExecutionPhase {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+func (x *TriggerRebalanceResponse) GetRebalanceId() string {
+	if x != nil {
+		return x.RebalanceId
+	}
+	return ""
+}
+
+// ExecutionPhase is one submission of a rebalance's orders and its fills.
+type ExecutionPhase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Schedule string `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"` // e.g., "weekly", "monthly", "daily"
+	Name        string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // sells or buys
+	Status      string       `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // COMPLETED, PARTIAL, FAILED, PENDING if the fills did not arrive in time, or SKIPPED
+	ExecutionId string       `protobuf:"bytes,3,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	Orders      []*Order     `protobuf:"bytes,4,rep,name=orders,proto3" json:"orders,omitempty"` // As submitted, after buys were sized to the available cash
+	Fills       []*OrderFill `protobuf:"bytes,5,rep,name=fills,proto3" json:"fills,omitempty"`
+	CashBefore  float64      `protobuf:"fixed64,6,opt,name=cash_before,json=cashBefore,proto3" json:"cash_before,omitempty"`
+	CashAfter   float64      `protobuf:"fixed64,7,opt,name=cash_after,json=cashAfter,proto3" json:"cash_after,omitempty"`
+	Message     string       `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *UpdateRebalanceScheduleRequest) Reset() {
-	*x = UpdateRebalanceScheduleRequest{}
+func (x *ExecutionPhase) Reset() {
+	*x = ExecutionPhase{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_portfolio_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -516,13 +628,13 @@ func (x *UpdateRebalanceScheduleRequest) Reset() {
 	}
 }
 
-func (x *UpdateRebalanceScheduleRequest) String() string {
+func (x *ExecutionPhase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRebalanceScheduleRequest) ProtoMessage() {}
+func (*ExecutionPhase) ProtoMessage() {}
 
-func (x *UpdateRebalanceScheduleRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecutionPhase) ProtoReflect() protoreflect.Message {
 	mi := &file_portfolio_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -534,29 +646,81 @@ func (x *UpdateRebalanceScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRebalanceScheduleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRebalanceScheduleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecutionPhase.ProtoReflect.Descriptor instead.
+func (*ExecutionPhase) Descriptor() ([]byte, []int) {
 	return file_portfolio_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateRebalanceScheduleRequest) GetSchedule() string {
+func (x *ExecutionPhase) GetName() string {
 	if x != nil {
-		return x.Schedule
+		return x.Name
 	}
 	return ""
 }
 
-type UpdateRebalanceScheduleResponse struct {
+func (x *ExecutionPhase) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExecutionPhase) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *ExecutionPhase) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ExecutionPhase) GetFills() []*OrderFill {
+	if x != nil {
+		return x.Fills
+	}
+	return nil
+}
+
+func (x *ExecutionPhase) GetCashBefore() float64 {
+	if x != nil {
+		return x.CashBefore
+	}
+	return 0
+}
+
+func (x *ExecutionPhase) GetCashAfter() float64 {
+	if x != nil {
+		return x.CashAfter
+	}
+	return 0
+}
+
+func (x *ExecutionPhase) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type OrderFill struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Symbol            string  `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	RequestedQuantity int32   `protobuf:"varint,2,opt,name=requested_quantity,json=requestedQuantity,proto3" json:"requested_quantity,omitempty"`
+	FilledQuantity    int32   `protobuf:"varint,3,opt,name=filled_quantity,json=filledQuantity,proto3" json:"filled_quantity,omitempty"`
+	AveragePrice      float64 `protobuf:"fixed64,4,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	Status            string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *UpdateRebalanceScheduleResponse) Reset() {
-	*x = UpdateRebalanceScheduleResponse{}
+func (x *OrderFill) Reset() {
+	*x = OrderFill{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_portfolio_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -564,13 +728,13 @@ func (x *UpdateRebalanceScheduleResponse) Reset() {
 	}
 }
 
-func (x *UpdateRebalanceScheduleResponse) String() string {
+func (x *OrderFill) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRebalanceScheduleResponse) ProtoMessage() {}
+func (*OrderFill) ProtoMessage() {}
 
-func (x *UpdateRebalanceScheduleResponse) ProtoReflect() protoreflect.Message {
+func (x *OrderFill) ProtoReflect() protoreflect.Message {
 	mi := &file_portfolio_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -582,214 +746,3214 @@ func (x *UpdateRebalanceScheduleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRebalanceScheduleResponse.ProtoReflect.Descriptor instead.
-func (*UpdateRebalanceScheduleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderFill.ProtoReflect.Descriptor instead.
+func (*OrderFill) Descriptor() ([]byte, []int) {
 	return file_portfolio_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateRebalanceScheduleResponse) GetSuccess() bool {
+func (x *OrderFill) GetSymbol() string {
 	if x != nil {
-		return x.Success
+		return x.Symbol
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateRebalanceScheduleResponse) GetMessage() string {
+func (x *OrderFill) GetRequestedQuantity() int32 {
 	if x != nil {
-		return x.Message
+		return x.RequestedQuantity
+	}
+	return 0
+}
+
+func (x *OrderFill) GetFilledQuantity() int32 {
+	if x != nil {
+		return x.FilledQuantity
+	}
+	return 0
+}
+
+func (x *OrderFill) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *OrderFill) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-var File_portfolio_service_proto protoreflect.FileDescriptor
+type PreviewRebalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_portfolio_service_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x70, 0x6f, 0x72, 0x74, 0x66,
-	0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x16, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x4f, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x07,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x07, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x6c, 0x73, 0x22, 0x49, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
-	0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22,
-	0x82, 0x01, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x22, 0x21, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x69, 0x72,
-	0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x72, 0x74,
-	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x61, 0x73, 0x68,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x08, 0x50, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a,
-	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x19, 0x0a, 0x17, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x18,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3c, 0x0a, 0x1e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x55, 0x0a, 0x1f, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2a, 0x22, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a,
-	0x0a, 0x06, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49,
-	0x4d, 0x49, 0x54, 0x10, 0x01, 0x32, 0xdc, 0x03, 0x0a, 0x10, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
-	0x6c, 0x69, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x70,
-	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
-	0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x71, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x50,
-	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x2e,
-	0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x66,
-	0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
-	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66,
-	0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x80, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x30, 0x2e,
-	0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x31, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x42, 0x37, 0x5a, 0x35, 0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x75, 0x6d,
-	0x2d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x72, 0x74,
-	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	PortfolioId string `protobuf:"bytes,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"` // Portfolio to act on, the default portfolio when empty
 }
 
-var (
-	file_portfolio_service_proto_rawDescOnce sync.Once
-	file_portfolio_service_proto_rawDescData = file_portfolio_service_proto_rawDesc
-)
+func (x *PreviewRebalanceRequest) Reset() {
+	*x = PreviewRebalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_portfolio_service_proto_rawDescGZIP() []byte {
-	file_portfolio_service_proto_rawDescOnce.Do(func() {
-		file_portfolio_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_portfolio_service_proto_rawDescData)
-	})
-	return file_portfolio_service_proto_rawDescData
+func (x *PreviewRebalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_portfolio_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_portfolio_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_portfolio_service_proto_goTypes = []any{
-	(OrderType)(0),                          // 0: portfolioservice.OrderType
-	(*GenerateOrdersRequest)(nil),           // 1: portfolioservice.GenerateOrdersRequest
-	(*GenerateOrdersResponse)(nil),          // 2: portfolioservice.GenerateOrdersResponse
-	(*Order)(nil),                           // 3: portfolioservice.Order
-	(*GetDesiredPortfolioStateRequest)(nil), // 4: portfolioservice.GetDesiredPortfolioStateRequest
-	(*PortfolioState)(nil),                  // 5: portfolioservice.PortfolioState
-	(*Position)(nil),                        // 6: portfolioservice.Position
-	(*TriggerRebalanceRequest)(nil),         // 7: portfolioservice.TriggerRebalanceRequest
-	(*TriggerRebalanceResponse)(nil),        // 8: portfolioservice.TriggerRebalanceResponse
-	(*UpdateRebalanceScheduleRequest)(nil),  // 9: portfolioservice.UpdateRebalanceScheduleRequest
-	(*UpdateRebalanceScheduleResponse)(nil), // 10: portfolioservice.UpdateRebalanceScheduleResponse
-	(*strategy_service.StockSignal)(nil),    // 11: strategyservice.StockSignal
+func (*PreviewRebalanceRequest) ProtoMessage() {}
+
+func (x *PreviewRebalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_portfolio_service_proto_depIdxs = []int32{
-	11, // 0: portfolioservice.GenerateOrdersRequest.signals:type_name -> strategyservice.StockSignal
-	3,  // 1: portfolioservice.GenerateOrdersResponse.orders:type_name -> portfolioservice.Order
-	0,  // 2: portfolioservice.Order.type:type_name -> portfolioservice.OrderType
-	6,  // 3: portfolioservice.PortfolioState.positions:type_name -> portfolioservice.Position
-	1,  // 4: portfolioservice.PortfolioService.GenerateOrders:input_type -> portfolioservice.GenerateOrdersRequest
-	4,  // 5: portfolioservice.PortfolioService.GetDesiredPortfolioState:input_type -> portfolioservice.GetDesiredPortfolioStateRequest
-	7,  // 6: portfolioservice.PortfolioService.TriggerRebalance:input_type -> portfolioservice.TriggerRebalanceRequest
-	9,  // 7: portfolioservice.PortfolioService.UpdateRebalanceSchedule:input_type -> portfolioservice.UpdateRebalanceScheduleRequest
-	2,  // 8: portfolioservice.PortfolioService.GenerateOrders:output_type -> portfolioservice.GenerateOrdersResponse
-	5,  // 9: portfolioservice.PortfolioService.GetDesiredPortfolioState:output_type -> portfolioservice.PortfolioState
-	8,  // 10: portfolioservice.PortfolioService.TriggerRebalance:output_type -> portfolioservice.TriggerRebalanceResponse
-	10, // 11: portfolioservice.PortfolioService.UpdateRebalanceSchedule:output_type -> portfolioservice.UpdateRebalanceScheduleResponse
-	8,  // [8:12] is the sub-list for method output_type
-	4,  // [4:8] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+
+// Deprecated: Use PreviewRebalanceRequest.ProtoReflect.Descriptor instead.
+func (*PreviewRebalanceRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{10}
 }
 
-func init() { file_portfolio_service_proto_init() }
-func file_portfolio_service_proto_init() {
-	if File_portfolio_service_proto != nil {
-		return
+func (x *PreviewRebalanceRequest) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_portfolio_service_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*GenerateOrdersRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_portfolio_service_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GenerateOrdersResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_portfolio_service_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*Order); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+// RebalancePreview is what a rebalance would trade, valued at the signal prices.
+type RebalancePreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Positions       []*PositionChange `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	Orders          []*Order          `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+	TotalValue      float64           `protobuf:"fixed64,3,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	CashBefore      float64           `protobuf:"fixed64,4,opt,name=cash_before,json=cashBefore,proto3" json:"cash_before,omitempty"`
+	CashAfter       float64           `protobuf:"fixed64,5,opt,name=cash_after,json=cashAfter,proto3" json:"cash_after,omitempty"` // After trades and estimated costs
+	EstimatedCosts  float64           `protobuf:"fixed64,6,opt,name=estimated_costs,json=estimatedCosts,proto3" json:"estimated_costs,omitempty"`
+	Turnover        float64           `protobuf:"fixed64,7,opt,name=turnover,proto3" json:"turnover,omitempty"` // Traded value as a fraction of the portfolio value
+	MarketRegime    string            `protobuf:"bytes,8,opt,name=market_regime,json=marketRegime,proto3" json:"market_regime,omitempty"`
+	TargetExposure  float64           `protobuf:"fixed64,9,opt,name=target_exposure,json=targetExposure,proto3" json:"target_exposure,omitempty"`
+	StrategyName    string            `protobuf:"bytes,10,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
+	GeneratedAt     string            `protobuf:"bytes,11,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Violations      []*RiskViolation  `protobuf:"bytes,12,rep,name=violations,proto3" json:"violations,omitempty"`
+	Blocked         bool              `protobuf:"varint,13,opt,name=blocked,proto3" json:"blocked,omitempty"`                                         // A blocking risk rule was breached and no orders can be submitted
+	AvoidedTurnover float64           `protobuf:"fixed64,14,opt,name=avoided_turnover,json=avoidedTurnover,proto3" json:"avoided_turnover,omitempty"` // Turnover the rebalance bands saved, as a fraction of the portfolio value
+}
+
+func (x *RebalancePreview) Reset() {
+	*x = RebalancePreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalancePreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalancePreview) ProtoMessage() {}
+
+func (x *RebalancePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalancePreview.ProtoReflect.Descriptor instead.
+func (*RebalancePreview) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RebalancePreview) GetPositions() []*PositionChange {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *RebalancePreview) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *RebalancePreview) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *RebalancePreview) GetCashBefore() float64 {
+	if x != nil {
+		return x.CashBefore
+	}
+	return 0
+}
+
+func (x *RebalancePreview) GetCashAfter() float64 {
+	if x != nil {
+		return x.CashAfter
+	}
+	return 0
+}
+
+func (x *RebalancePreview) GetEstimatedCosts() float64 {
+	if x != nil {
+		return x.EstimatedCosts
+	}
+	return 0
+}
+
+func (x *RebalancePreview) GetTurnover() float64 {
+	if x != nil {
+		return x.Turnover
+	}
+	return 0
+}
+
+func (x *RebalancePreview) GetMarketRegime() string {
+	if x != nil {
+		return x.MarketRegime
+	}
+	return ""
+}
+
+func (x *RebalancePreview) GetTargetExposure() float64 {
+	if x != nil {
+		return x.TargetExposure
+	}
+	return 0
+}
+
+func (x *RebalancePreview) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *RebalancePreview) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+func (x *RebalancePreview) GetViolations() []*RiskViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *RebalancePreview) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *RebalancePreview) GetAvoidedTurnover() float64 {
+	if x != nil {
+		return x.AvoidedTurnover
+	}
+	return 0
+}
+
+type PositionChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol          string  `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	CurrentQuantity int32   `protobuf:"varint,2,opt,name=current_quantity,json=currentQuantity,proto3" json:"current_quantity,omitempty"`
+	TargetQuantity  int32   `protobuf:"varint,3,opt,name=target_quantity,json=targetQuantity,proto3" json:"target_quantity,omitempty"`
+	Price           float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	CurrentWeight   float64 `protobuf:"fixed64,5,opt,name=current_weight,json=currentWeight,proto3" json:"current_weight,omitempty"`
+	TargetWeight    float64 `protobuf:"fixed64,6,opt,name=target_weight,json=targetWeight,proto3" json:"target_weight,omitempty"`
+	TradeQuantity   int32   `protobuf:"varint,7,opt,name=trade_quantity,json=tradeQuantity,proto3" json:"trade_quantity,omitempty"` // Positive buys, negative sells
+	TradeValue      float64 `protobuf:"fixed64,8,opt,name=trade_value,json=tradeValue,proto3" json:"trade_value,omitempty"`
+	KeptReason      string  `protobuf:"bytes,9,opt,name=kept_reason,json=keptReason,proto3" json:"kept_reason,omitempty"` // Why the position was left as it is instead of trading to its allocation
+}
+
+func (x *PositionChange) Reset() {
+	*x = PositionChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PositionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionChange) ProtoMessage() {}
+
+func (x *PositionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionChange.ProtoReflect.Descriptor instead.
+func (*PositionChange) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PositionChange) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PositionChange) GetCurrentQuantity() int32 {
+	if x != nil {
+		return x.CurrentQuantity
+	}
+	return 0
+}
+
+func (x *PositionChange) GetTargetQuantity() int32 {
+	if x != nil {
+		return x.TargetQuantity
+	}
+	return 0
+}
+
+func (x *PositionChange) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PositionChange) GetCurrentWeight() float64 {
+	if x != nil {
+		return x.CurrentWeight
+	}
+	return 0
+}
+
+func (x *PositionChange) GetTargetWeight() float64 {
+	if x != nil {
+		return x.TargetWeight
+	}
+	return 0
+}
+
+func (x *PositionChange) GetTradeQuantity() int32 {
+	if x != nil {
+		return x.TradeQuantity
+	}
+	return 0
+}
+
+func (x *PositionChange) GetTradeValue() float64 {
+	if x != nil {
+		return x.TradeValue
+	}
+	return 0
+}
+
+func (x *PositionChange) GetKeptReason() string {
+	if x != nil {
+		return x.KeptReason
+	}
+	return ""
+}
+
+type UpdateRebalanceScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "daily", "weekly" (every Wednesday), "monthly" (second Wednesday of the month), "every friday",
+	// "last friday of month", optionally followed by "at 15:30", or a cron expression such as
+	// "30 10 * * 3". Times are in the exchange time zone and runs only happen on trading days. An
+	// empty schedule turns scheduled rebalances off.
+	Schedule    string `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	PortfolioId string `protobuf:"bytes,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"` // Portfolio to act on, the default portfolio when empty
+}
+
+func (x *UpdateRebalanceScheduleRequest) Reset() {
+	*x = UpdateRebalanceScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRebalanceScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRebalanceScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateRebalanceScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRebalanceScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRebalanceScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateRebalanceScheduleRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *UpdateRebalanceScheduleRequest) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
+type UpdateRebalanceScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	NextRun string `protobuf:"bytes,3,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+}
+
+func (x *UpdateRebalanceScheduleResponse) Reset() {
+	*x = UpdateRebalanceScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRebalanceScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRebalanceScheduleResponse) ProtoMessage() {}
+
+func (x *UpdateRebalanceScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRebalanceScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRebalanceScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateRebalanceScheduleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateRebalanceScheduleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateRebalanceScheduleResponse) GetNextRun() string {
+	if x != nil {
+		return x.NextRun
+	}
+	return ""
+}
+
+type GetRebalanceScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Upcoming    int32  `protobuf:"varint,1,opt,name=upcoming,proto3" json:"upcoming,omitempty"`                         // Number of upcoming runs to list, default 5
+	PortfolioId string `protobuf:"bytes,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"` // Portfolio to act on, the default portfolio when empty
+}
+
+func (x *GetRebalanceScheduleRequest) Reset() {
+	*x = GetRebalanceScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRebalanceScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebalanceScheduleRequest) ProtoMessage() {}
+
+func (x *GetRebalanceScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebalanceScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetRebalanceScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetRebalanceScheduleRequest) GetUpcoming() int32 {
+	if x != nil {
+		return x.Upcoming
+	}
+	return 0
+}
+
+func (x *GetRebalanceScheduleRequest) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
+// RebalanceSchedule reports the schedule and its runs. Times are RFC 3339 in the exchange time zone.
+type RebalanceSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedule     string   `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Timezone     string   `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	LastRun      string   `protobuf:"bytes,3,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	LastStatus   string   `protobuf:"bytes,4,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"`
+	LastError    string   `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextRun      string   `protobuf:"bytes,6,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	UpcomingRuns []string `protobuf:"bytes,7,rep,name=upcoming_runs,json=upcomingRuns,proto3" json:"upcoming_runs,omitempty"`
+}
+
+func (x *RebalanceSchedule) Reset() {
+	*x = RebalanceSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalanceSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceSchedule) ProtoMessage() {}
+
+func (x *RebalanceSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceSchedule.ProtoReflect.Descriptor instead.
+func (*RebalanceSchedule) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RebalanceSchedule) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *RebalanceSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *RebalanceSchedule) GetLastRun() string {
+	if x != nil {
+		return x.LastRun
+	}
+	return ""
+}
+
+func (x *RebalanceSchedule) GetLastStatus() string {
+	if x != nil {
+		return x.LastStatus
+	}
+	return ""
+}
+
+func (x *RebalanceSchedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *RebalanceSchedule) GetNextRun() string {
+	if x != nil {
+		return x.NextRun
+	}
+	return ""
+}
+
+func (x *RebalanceSchedule) GetUpcomingRuns() []string {
+	if x != nil {
+		return x.UpcomingRuns
+	}
+	return nil
+}
+
+// PortfolioConfiguration decides which signals a rebalance trades on.
+type PortfolioConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Universe              []string           `protobuf:"bytes,1,rep,name=universe,proto3" json:"universe,omitempty"`                                                                                                                                       // Symbols to rank; empty uses the strategy's default universe and requires one
+	MarketIndex           string             `protobuf:"bytes,2,opt,name=market_index,json=marketIndex,proto3" json:"market_index,omitempty"`                                                                                                              // Default "^GSPC"
+	StrategyName          string             `protobuf:"bytes,3,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`                                                                                                           // Default "momentum"
+	StrategyParameters    map[string]string  `protobuf:"bytes,4,rep,name=strategy_parameters,json=strategyParameters,proto3" json:"strategy_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Applied to a separate strategy instance for this portfolio
+	LookbackDays          int32              `protobuf:"varint,5,opt,name=lookback_days,json=lookbackDays,proto3" json:"lookback_days,omitempty"`                                                                                                          // Calendar days of history requested for signals, default 400
+	Interval              string             `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`                                                                                                                                       // Default "1d"
+	UpdatedAt             string             `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CostBps               float64            `protobuf:"fixed64,8,opt,name=cost_bps,json=costBps,proto3" json:"cost_bps,omitempty"` // Estimated trading cost in basis points of traded value, default 10
+	RiskRules             *RiskRules         `protobuf:"bytes,9,opt,name=risk_rules,json=riskRules,proto3" json:"risk_rules,omitempty"`
+	RebalanceBands        *RebalanceBands    `protobuf:"bytes,10,opt,name=rebalance_bands,json=rebalanceBands,proto3" json:"rebalance_bands,omitempty"`
+	Optimizer             *OptimizerSettings `protobuf:"bytes,11,opt,name=optimizer,proto3" json:"optimizer,omitempty"`
+	ApprovalRequired      bool               `protobuf:"varint,12,opt,name=approval_required,json=approvalRequired,proto3" json:"approval_required,omitempty"`                  // Rebalances only propose orders, which go out once approved
+	ApprovalExpiryMinutes int32              `protobuf:"varint,13,opt,name=approval_expiry_minutes,json=approvalExpiryMinutes,proto3" json:"approval_expiry_minutes,omitempty"` // Proposals not decided within this expire, default 360
+}
+
+func (x *PortfolioConfiguration) Reset() {
+	*x = PortfolioConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortfolioConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioConfiguration) ProtoMessage() {}
+
+func (x *PortfolioConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioConfiguration.ProtoReflect.Descriptor instead.
+func (*PortfolioConfiguration) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PortfolioConfiguration) GetUniverse() []string {
+	if x != nil {
+		return x.Universe
+	}
+	return nil
+}
+
+func (x *PortfolioConfiguration) GetMarketIndex() string {
+	if x != nil {
+		return x.MarketIndex
+	}
+	return ""
+}
+
+func (x *PortfolioConfiguration) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *PortfolioConfiguration) GetStrategyParameters() map[string]string {
+	if x != nil {
+		return x.StrategyParameters
+	}
+	return nil
+}
+
+func (x *PortfolioConfiguration) GetLookbackDays() int32 {
+	if x != nil {
+		return x.LookbackDays
+	}
+	return 0
+}
+
+func (x *PortfolioConfiguration) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *PortfolioConfiguration) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *PortfolioConfiguration) GetCostBps() float64 {
+	if x != nil {
+		return x.CostBps
+	}
+	return 0
+}
+
+func (x *PortfolioConfiguration) GetRiskRules() *RiskRules {
+	if x != nil {
+		return x.RiskRules
+	}
+	return nil
+}
+
+func (x *PortfolioConfiguration) GetRebalanceBands() *RebalanceBands {
+	if x != nil {
+		return x.RebalanceBands
+	}
+	return nil
+}
+
+func (x *PortfolioConfiguration) GetOptimizer() *OptimizerSettings {
+	if x != nil {
+		return x.Optimizer
+	}
+	return nil
+}
+
+func (x *PortfolioConfiguration) GetApprovalRequired() bool {
+	if x != nil {
+		return x.ApprovalRequired
+	}
+	return false
+}
+
+func (x *PortfolioConfiguration) GetApprovalExpiryMinutes() int32 {
+	if x != nil {
+		return x.ApprovalExpiryMinutes
+	}
+	return 0
+}
+
+// OptimizerSettings choose how the target weights are set across the positions the strategy
+// selects. Volatilities and covariances come from daily returns of adjusted closes.
+type OptimizerSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// riskUnits (default, the strategy's risk units), equalWeight, inverseVolatility, riskParity,
+	// minVariance or meanVariance
+	Method         string  `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	CovarianceDays int32   `protobuf:"varint,2,opt,name=covariance_days,json=covarianceDays,proto3" json:"covariance_days,omitempty"` // Daily returns used for volatility and covariance, default 126
+	MaxWeight      float64 `protobuf:"fixed64,3,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`               // Upper bound per position for every method, 0 for none
+	RiskAversion   float64 `protobuf:"fixed64,4,opt,name=risk_aversion,json=riskAversion,proto3" json:"risk_aversion,omitempty"`      // meanVariance penalty on annualized variance against returns ranked from the momentum scores, default 10
+}
+
+func (x *OptimizerSettings) Reset() {
+	*x = OptimizerSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizerSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizerSettings) ProtoMessage() {}
+
+func (x *OptimizerSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizerSettings.ProtoReflect.Descriptor instead.
+func (*OptimizerSettings) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OptimizerSettings) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *OptimizerSettings) GetCovarianceDays() int32 {
+	if x != nil {
+		return x.CovarianceDays
+	}
+	return 0
+}
+
+func (x *OptimizerSettings) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *OptimizerSettings) GetRiskAversion() float64 {
+	if x != nil {
+		return x.RiskAversion
+	}
+	return 0
+}
+
+// RebalanceBands limit trading to positions that have materially moved. A position is resized only
+// when its weight drifts from the target by more than either band; entries and resizes smaller
+// than min_trade_value are skipped, while exits always trade. Zero disables a setting.
+type RebalanceBands struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AbsoluteDrift float64 `protobuf:"fixed64,1,opt,name=absolute_drift,json=absoluteDrift,proto3" json:"absolute_drift,omitempty"` // Weight difference, e.g. 0.02 for two percentage points
+	RelativeDrift float64 `protobuf:"fixed64,2,opt,name=relative_drift,json=relativeDrift,proto3" json:"relative_drift,omitempty"` // Fraction of the target weight, e.g. 0.25
+	MinTradeValue float64 `protobuf:"fixed64,3,opt,name=min_trade_value,json=minTradeValue,proto3" json:"min_trade_value,omitempty"`
+	BufferRank    int32   `protobuf:"varint,4,opt,name=buffer_rank,json=bufferRank,proto3" json:"buffer_rank,omitempty"` // Keep a holding the strategy no longer selects while its rank is within this
+}
+
+func (x *RebalanceBands) Reset() {
+	*x = RebalanceBands{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalanceBands) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceBands) ProtoMessage() {}
+
+func (x *RebalanceBands) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceBands.ProtoReflect.Descriptor instead.
+func (*RebalanceBands) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RebalanceBands) GetAbsoluteDrift() float64 {
+	if x != nil {
+		return x.AbsoluteDrift
+	}
+	return 0
+}
+
+func (x *RebalanceBands) GetRelativeDrift() float64 {
+	if x != nil {
+		return x.RelativeDrift
+	}
+	return 0
+}
+
+func (x *RebalanceBands) GetMinTradeValue() float64 {
+	if x != nil {
+		return x.MinTradeValue
+	}
+	return 0
+}
+
+func (x *RebalanceBands) GetBufferRank() int32 {
+	if x != nil {
+		return x.BufferRank
+	}
+	return 0
+}
+
+// RiskRules are portfolio-level limits applied to the target portfolio before orders are
+// generated. Weights are fractions of the portfolio value and a limit of zero is disabled.
+type RiskRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxPositionWeight *RiskLimit `protobuf:"bytes,1,opt,name=max_position_weight,json=maxPositionWeight,proto3" json:"max_position_weight,omitempty"`
+	MaxSectorWeight   *RiskLimit `protobuf:"bytes,2,opt,name=max_sector_weight,json=maxSectorWeight,proto3" json:"max_sector_weight,omitempty"`
+	MaxPositions      *RiskLimit `protobuf:"bytes,3,opt,name=max_positions,json=maxPositions,proto3" json:"max_positions,omitempty"` // Number of names
+	MinCashBuffer     *RiskLimit `protobuf:"bytes,4,opt,name=min_cash_buffer,json=minCashBuffer,proto3" json:"min_cash_buffer,omitempty"`
+	MaxGrossExposure  *RiskLimit `protobuf:"bytes,5,opt,name=max_gross_exposure,json=maxGrossExposure,proto3" json:"max_gross_exposure,omitempty"`
+	MaxTurnover       *RiskLimit `protobuf:"bytes,6,opt,name=max_turnover,json=maxTurnover,proto3" json:"max_turnover,omitempty"` // Value traded per day, earlier rebalances included, as a fraction of the portfolio value
+}
+
+func (x *RiskRules) Reset() {
+	*x = RiskRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RiskRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskRules) ProtoMessage() {}
+
+func (x *RiskRules) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskRules.ProtoReflect.Descriptor instead.
+func (*RiskRules) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RiskRules) GetMaxPositionWeight() *RiskLimit {
+	if x != nil {
+		return x.MaxPositionWeight
+	}
+	return nil
+}
+
+func (x *RiskRules) GetMaxSectorWeight() *RiskLimit {
+	if x != nil {
+		return x.MaxSectorWeight
+	}
+	return nil
+}
+
+func (x *RiskRules) GetMaxPositions() *RiskLimit {
+	if x != nil {
+		return x.MaxPositions
+	}
+	return nil
+}
+
+func (x *RiskRules) GetMinCashBuffer() *RiskLimit {
+	if x != nil {
+		return x.MinCashBuffer
+	}
+	return nil
+}
+
+func (x *RiskRules) GetMaxGrossExposure() *RiskLimit {
+	if x != nil {
+		return x.MaxGrossExposure
+	}
+	return nil
+}
+
+func (x *RiskRules) GetMaxTurnover() *RiskLimit {
+	if x != nil {
+		return x.MaxTurnover
+	}
+	return nil
+}
+
+type RiskLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  float64    `protobuf:"fixed64,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Action RiskAction `protobuf:"varint,2,opt,name=action,proto3,enum=portfolioservice.RiskAction" json:"action,omitempty"`
+}
+
+func (x *RiskLimit) Reset() {
+	*x = RiskLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RiskLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskLimit) ProtoMessage() {}
+
+func (x *RiskLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskLimit.ProtoReflect.Descriptor instead.
+func (*RiskLimit) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RiskLimit) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RiskLimit) GetAction() RiskAction {
+	if x != nil {
+		return x.Action
+	}
+	return RiskAction_CLIP
+}
+
+type RiskViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule    string     `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`       // e.g. max_position_weight
+	Subject string     `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"` // Symbol or sector the violation is about, empty for portfolio-wide rules
+	Value   float64    `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`   // Value before clipping
+	Limit   float64    `protobuf:"fixed64,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Action  RiskAction `protobuf:"varint,5,opt,name=action,proto3,enum=portfolioservice.RiskAction" json:"action,omitempty"`
+}
+
+func (x *RiskViolation) Reset() {
+	*x = RiskViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RiskViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskViolation) ProtoMessage() {}
+
+func (x *RiskViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskViolation.ProtoReflect.Descriptor instead.
+func (*RiskViolation) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RiskViolation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RiskViolation) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *RiskViolation) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *RiskViolation) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RiskViolation) GetAction() RiskAction {
+	if x != nil {
+		return x.Action
+	}
+	return RiskAction_CLIP
+}
+
+type ConfigurePortfolioRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configuration *PortfolioConfiguration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	PortfolioId   string                  `protobuf:"bytes,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"` // Portfolio to act on, the default portfolio when empty
+}
+
+func (x *ConfigurePortfolioRequest) Reset() {
+	*x = ConfigurePortfolioRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigurePortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurePortfolioRequest) ProtoMessage() {}
+
+func (x *ConfigurePortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurePortfolioRequest.ProtoReflect.Descriptor instead.
+func (*ConfigurePortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ConfigurePortfolioRequest) GetConfiguration() *PortfolioConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *ConfigurePortfolioRequest) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
+type ConfigurePortfolioResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Configuration *PortfolioConfiguration `protobuf:"bytes,3,opt,name=configuration,proto3" json:"configuration,omitempty"`
+}
+
+func (x *ConfigurePortfolioResponse) Reset() {
+	*x = ConfigurePortfolioResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigurePortfolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurePortfolioResponse) ProtoMessage() {}
+
+func (x *ConfigurePortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurePortfolioResponse.ProtoReflect.Descriptor instead.
+func (*ConfigurePortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ConfigurePortfolioResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfigurePortfolioResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfigurePortfolioResponse) GetConfiguration() *PortfolioConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+type GetPortfolioConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PortfolioId string `protobuf:"bytes,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"` // Portfolio to act on, the default portfolio when empty
+}
+
+func (x *GetPortfolioConfigurationRequest) Reset() {
+	*x = GetPortfolioConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPortfolioConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioConfigurationRequest) ProtoMessage() {}
+
+func (x *GetPortfolioConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPortfolioConfigurationRequest) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
+// RebalanceRecord is the stored audit trail of one rebalance run. Times are RFC 3339 in UTC.
+type RebalanceRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Trigger string `protobuf:"bytes,2,opt,name=trigger,proto3" json:"trigger,omitempty"` // scheduled or manual
+	// SUCCEEDED or FAILED; with approval required PENDING_APPROVAL until the proposal is APPROVED
+	// (then SUCCEEDED or FAILED once executed), REJECTED or EXPIRED
+	Status         string                           `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	StartedAt      string                           `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt     string                           `protobuf:"bytes,5,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Configuration  *PortfolioConfiguration          `protobuf:"bytes,6,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Signals        *strategy_service.SignalResponse `protobuf:"bytes,7,opt,name=signals,proto3" json:"signals,omitempty"`
+	Plan           *RebalancePreview                `protobuf:"bytes,8,opt,name=plan,proto3" json:"plan,omitempty"` // Current and target positions, orders and risk rule violations
+	Phases         []*ExecutionPhase                `protobuf:"bytes,9,rep,name=phases,proto3" json:"phases,omitempty"`
+	ResultingState *PortfolioState                  `protobuf:"bytes,10,opt,name=resulting_state,json=resultingState,proto3" json:"resulting_state,omitempty"`
+	Error          string                           `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	ExpiresAt      string                           `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // When a proposal awaiting approval expires
+	Approval       *RebalanceApproval               `protobuf:"bytes,13,opt,name=approval,proto3" json:"approval,omitempty"`
+	PortfolioId    string                           `protobuf:"bytes,14,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+}
+
+func (x *RebalanceRecord) Reset() {
+	*x = RebalanceRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalanceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceRecord) ProtoMessage() {}
+
+func (x *RebalanceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceRecord.ProtoReflect.Descriptor instead.
+func (*RebalanceRecord) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RebalanceRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RebalanceRecord) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *RebalanceRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RebalanceRecord) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *RebalanceRecord) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *RebalanceRecord) GetConfiguration() *PortfolioConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *RebalanceRecord) GetSignals() *strategy_service.SignalResponse {
+	if x != nil {
+		return x.Signals
+	}
+	return nil
+}
+
+func (x *RebalanceRecord) GetPlan() *RebalancePreview {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *RebalanceRecord) GetPhases() []*ExecutionPhase {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+func (x *RebalanceRecord) GetResultingState() *PortfolioState {
+	if x != nil {
+		return x.ResultingState
+	}
+	return nil
+}
+
+func (x *RebalanceRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RebalanceRecord) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *RebalanceRecord) GetApproval() *RebalanceApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *RebalanceRecord) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
+type RebalanceApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Decision       string   `protobuf:"bytes,1,opt,name=decision,proto3" json:"decision,omitempty"` // APPROVED or REJECTED
+	DecidedBy      string   `protobuf:"bytes,2,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	Comment        string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	DecidedAt      string   `protobuf:"bytes,4,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	ApprovedOrders []*Order `protobuf:"bytes,5,rep,name=approved_orders,json=approvedOrders,proto3" json:"approved_orders,omitempty"` // The orders released for execution, after edits
+}
+
+func (x *RebalanceApproval) Reset() {
+	*x = RebalanceApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalanceApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceApproval) ProtoMessage() {}
+
+func (x *RebalanceApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceApproval.ProtoReflect.Descriptor instead.
+func (*RebalanceApproval) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RebalanceApproval) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *RebalanceApproval) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *RebalanceApproval) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *RebalanceApproval) GetDecidedAt() string {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return ""
+}
+
+func (x *RebalanceApproval) GetApprovedOrders() []*Order {
+	if x != nil {
+		return x.ApprovedOrders
+	}
+	return nil
+}
+
+type ListRebalancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit       int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                               // Default 20, at most 200
+	Status      string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                              // Only runs with this status
+	Trigger     string `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"`                            // Only runs with this trigger
+	Before      string `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`                              // Only runs started before this RFC 3339 time, for paging back
+	PortfolioId string `protobuf:"bytes,5,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"` // Portfolio to act on, the default portfolio when empty
+}
+
+func (x *ListRebalancesRequest) Reset() {
+	*x = ListRebalancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRebalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRebalancesRequest) ProtoMessage() {}
+
+func (x *ListRebalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRebalancesRequest.ProtoReflect.Descriptor instead.
+func (*ListRebalancesRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListRebalancesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRebalancesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRebalancesRequest) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *ListRebalancesRequest) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *ListRebalancesRequest) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
+type ListRebalancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rebalances []*RebalanceSummary `protobuf:"bytes,1,rep,name=rebalances,proto3" json:"rebalances,omitempty"` // Newest first
+}
+
+func (x *ListRebalancesResponse) Reset() {
+	*x = ListRebalancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRebalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRebalancesResponse) ProtoMessage() {}
+
+func (x *ListRebalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRebalancesResponse.ProtoReflect.Descriptor instead.
+func (*ListRebalancesResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListRebalancesResponse) GetRebalances() []*RebalanceSummary {
+	if x != nil {
+		return x.Rebalances
+	}
+	return nil
+}
+
+type RebalanceSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Trigger    string  `protobuf:"bytes,2,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Status     string  `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	StartedAt  string  `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt string  `protobuf:"bytes,5,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	OrderCount int32   `protobuf:"varint,6,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	Turnover   float64 `protobuf:"fixed64,7,opt,name=turnover,proto3" json:"turnover,omitempty"`
+	Error      string  `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RebalanceSummary) Reset() {
+	*x = RebalanceSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalanceSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceSummary) ProtoMessage() {}
+
+func (x *RebalanceSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceSummary.ProtoReflect.Descriptor instead.
+func (*RebalanceSummary) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RebalanceSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RebalanceSummary) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *RebalanceSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RebalanceSummary) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *RebalanceSummary) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *RebalanceSummary) GetOrderCount() int32 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *RebalanceSummary) GetTurnover() float64 {
+	if x != nil {
+		return x.Turnover
+	}
+	return 0
+}
+
+func (x *RebalanceSummary) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetRebalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PortfolioId string `protobuf:"bytes,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"` // Portfolio to act on, the default portfolio when empty
+}
+
+func (x *GetRebalanceRequest) Reset() {
+	*x = GetRebalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRebalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebalanceRequest) ProtoMessage() {}
+
+func (x *GetRebalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRebalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetRebalanceRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetRebalanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetRebalanceRequest) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
+type ApproveRebalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbols        []string `protobuf:"bytes,2,rep,name=symbols,proto3" json:"symbols,omitempty"`                                     // Approve only the orders for these symbols, all when empty
+	OrderOverrides []*Order `protobuf:"bytes,3,rep,name=order_overrides,json=orderOverrides,proto3" json:"order_overrides,omitempty"` // Smaller quantities for proposed orders by symbol, 0 drops the order
+	ApprovedBy     string   `protobuf:"bytes,4,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	Comment        string   `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	PortfolioId    string   `protobuf:"bytes,6,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"` // Portfolio to act on, the default portfolio when empty
+}
+
+func (x *ApproveRebalanceRequest) Reset() {
+	*x = ApproveRebalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveRebalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRebalanceRequest) ProtoMessage() {}
+
+func (x *ApproveRebalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRebalanceRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRebalanceRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ApproveRebalanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveRebalanceRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *ApproveRebalanceRequest) GetOrderOverrides() []*Order {
+	if x != nil {
+		return x.OrderOverrides
+	}
+	return nil
+}
+
+func (x *ApproveRebalanceRequest) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *ApproveRebalanceRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ApproveRebalanceRequest) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
+type ApproveRebalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rebalance *RebalanceRecord `protobuf:"bytes,3,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+}
+
+func (x *ApproveRebalanceResponse) Reset() {
+	*x = ApproveRebalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveRebalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRebalanceResponse) ProtoMessage() {}
+
+func (x *ApproveRebalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRebalanceResponse.ProtoReflect.Descriptor instead.
+func (*ApproveRebalanceResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ApproveRebalanceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApproveRebalanceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApproveRebalanceResponse) GetRebalance() *RebalanceRecord {
+	if x != nil {
+		return x.Rebalance
+	}
+	return nil
+}
+
+type RejectRebalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RejectedBy  string `protobuf:"bytes,2,opt,name=rejected_by,json=rejectedBy,proto3" json:"rejected_by,omitempty"`
+	Comment     string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	PortfolioId string `protobuf:"bytes,4,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"` // Portfolio to act on, the default portfolio when empty
+}
+
+func (x *RejectRebalanceRequest) Reset() {
+	*x = RejectRebalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectRebalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRebalanceRequest) ProtoMessage() {}
+
+func (x *RejectRebalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRebalanceRequest.ProtoReflect.Descriptor instead.
+func (*RejectRebalanceRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RejectRebalanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectRebalanceRequest) GetRejectedBy() string {
+	if x != nil {
+		return x.RejectedBy
+	}
+	return ""
+}
+
+func (x *RejectRebalanceRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *RejectRebalanceRequest) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
+type RejectRebalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rebalance *RebalanceRecord `protobuf:"bytes,3,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+}
+
+func (x *RejectRebalanceResponse) Reset() {
+	*x = RejectRebalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectRebalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRebalanceResponse) ProtoMessage() {}
+
+func (x *RejectRebalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRebalanceResponse.ProtoReflect.Descriptor instead.
+func (*RejectRebalanceResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RejectRebalanceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RejectRebalanceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RejectRebalanceResponse) GetRebalance() *RebalanceRecord {
+	if x != nil {
+		return x.Rebalance
+	}
+	return nil
+}
+
+type CreatePortfolioRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PortfolioId   string                  `protobuf:"bytes,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"` // Up to 64 letters, digits, dashes and underscores
+	Configuration *PortfolioConfiguration `protobuf:"bytes,2,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Schedule      string                  `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"` // Rebalance schedule, see UpdateRebalanceScheduleRequest, default weekly
+}
+
+func (x *CreatePortfolioRequest) Reset() {
+	*x = CreatePortfolioRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePortfolioRequest) ProtoMessage() {}
+
+func (x *CreatePortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePortfolioRequest.ProtoReflect.Descriptor instead.
+func (*CreatePortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreatePortfolioRequest) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
+func (x *CreatePortfolioRequest) GetConfiguration() *PortfolioConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *CreatePortfolioRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+type CreatePortfolioResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Configuration *PortfolioConfiguration `protobuf:"bytes,3,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	NextRun       string                  `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+}
+
+func (x *CreatePortfolioResponse) Reset() {
+	*x = CreatePortfolioResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePortfolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePortfolioResponse) ProtoMessage() {}
+
+func (x *CreatePortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePortfolioResponse.ProtoReflect.Descriptor instead.
+func (*CreatePortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreatePortfolioResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreatePortfolioResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreatePortfolioResponse) GetConfiguration() *PortfolioConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *CreatePortfolioResponse) GetNextRun() string {
+	if x != nil {
+		return x.NextRun
+	}
+	return ""
+}
+
+type ListPortfoliosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPortfoliosRequest) Reset() {
+	*x = ListPortfoliosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPortfoliosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortfoliosRequest) ProtoMessage() {}
+
+func (x *ListPortfoliosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortfoliosRequest.ProtoReflect.Descriptor instead.
+func (*ListPortfoliosRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{38}
+}
+
+type ListPortfoliosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Portfolios []*PortfolioSummary `protobuf:"bytes,1,rep,name=portfolios,proto3" json:"portfolios,omitempty"` // In id order
+}
+
+func (x *ListPortfoliosResponse) Reset() {
+	*x = ListPortfoliosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPortfoliosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortfoliosResponse) ProtoMessage() {}
+
+func (x *ListPortfoliosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortfoliosResponse.ProtoReflect.Descriptor instead.
+func (*ListPortfoliosResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListPortfoliosResponse) GetPortfolios() []*PortfolioSummary {
+	if x != nil {
+		return x.Portfolios
+	}
+	return nil
+}
+
+type PortfolioSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PortfolioId      string `protobuf:"bytes,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StrategyName     string `protobuf:"bytes,2,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
+	UniverseSize     int32  `protobuf:"varint,3,opt,name=universe_size,json=universeSize,proto3" json:"universe_size,omitempty"`
+	ApprovalRequired bool   `protobuf:"varint,4,opt,name=approval_required,json=approvalRequired,proto3" json:"approval_required,omitempty"`
+	Schedule         string `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	NextRun          string `protobuf:"bytes,6,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	LastStatus       string `protobuf:"bytes,7,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"`
+}
+
+func (x *PortfolioSummary) Reset() {
+	*x = PortfolioSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portfolio_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortfolioSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioSummary) ProtoMessage() {}
+
+func (x *PortfolioSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioSummary.ProtoReflect.Descriptor instead.
+func (*PortfolioSummary) Descriptor() ([]byte, []int) {
+	return file_portfolio_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PortfolioSummary) GetPortfolioId() string {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return ""
+}
+
+func (x *PortfolioSummary) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *PortfolioSummary) GetUniverseSize() int32 {
+	if x != nil {
+		return x.UniverseSize
+	}
+	return 0
+}
+
+func (x *PortfolioSummary) GetApprovalRequired() bool {
+	if x != nil {
+		return x.ApprovalRequired
+	}
+	return false
+}
+
+func (x *PortfolioSummary) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *PortfolioSummary) GetNextRun() string {
+	if x != nil {
+		return x.NextRun
+	}
+	return ""
+}
+
+func (x *PortfolioSummary) GetLastStatus() string {
+	if x != nil {
+		return x.LastStatus
+	}
+	return ""
+}
+
+var File_portfolio_service_proto protoreflect.FileDescriptor
+
+var file_portfolio_service_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x16, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a,
+	0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x07, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x65,
+	0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x22, 0x49, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x44, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x73, 0x69, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x22, 0x8e, 0x01,
+	0x0a, 0x0e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x38, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61,
+	0x73, 0x68, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x63, 0x61, 0x73, 0x68, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x86,
+	0x01, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x55, 0x0a, 0x17, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x22, 0xe9,
+	0x01, 0x0a, 0x18, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x3c, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x38, 0x0a,
+	0x06, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52,
+	0x06, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x9d, 0x02, 0x0a, 0x0e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a,
+	0x05, 0x66, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x6c, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x6c, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x61, 0x73, 0x68, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x63, 0x61, 0x73, 0x68, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x09, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
+	0x6f, 0x49, 0x64, 0x22, 0xc5, 0x04, 0x0a, 0x10, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x3e, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61,
+	0x73, 0x68, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x63, 0x61, 0x73, 0x68, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x61, 0x73, 0x68, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x63, 0x61, 0x73, 0x68, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x65,
+	0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x69, 0x73,
+	0x6b, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x12, 0x29, 0x0a, 0x10, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x75, 0x72, 0x6e,
+	0x6f, 0x76, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x61, 0x76, 0x6f, 0x69,
+	0x64, 0x65, 0x64, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x22, 0xc7, 0x02, 0x0a, 0x0e,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x70, 0x74, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x65, 0x70, 0x74, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x22, 0x5c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x73, 0x22,
+	0xe0, 0x05, 0x0a, 0x16, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x71,
+	0x0a, 0x13, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x62, 0x61,
+	0x63, 0x6b, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x73, 0x74, 0x42, 0x70, 0x73, 0x12, 0x3a, 0x0a, 0x0a,
+	0x72, 0x69, 0x73, 0x6b, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x72,
+	0x69, 0x73, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x72, 0x65, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61,
+	0x6e, 0x64, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61,
+	0x6e, 0x64, 0x73, 0x12, 0x41, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x72,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
+	0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69,
+	0x7a, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x09, 0x6f, 0x70, 0x74,
+	0x69, 0x6d, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d,
+	0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x69, 0x73, 0x6b,
+	0x5f, 0x61, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x72, 0x69, 0x73, 0x6b, 0x41, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa7, 0x01,
+	0x0a, 0x0e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x72, 0x69,
+	0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x22, 0xb3, 0x03, 0x0a, 0x09, 0x52, 0x69, 0x73, 0x6b,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x11, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x52, 0x69, 0x73, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x53,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a,
+	0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
+	0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x43, 0x61, 0x73, 0x68, 0x42, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x12, 0x49, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x5f,
+	0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x10, 0x6d, 0x61, 0x78,
+	0x47, 0x72, 0x6f, 0x73, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x3e, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x22, 0x57, 0x0a,
+	0x09, 0x52, 0x69, 0x73, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x34, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x52, 0x69, 0x73, 0x6b, 0x56,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x1a, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4e, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x20,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
+	0x6f, 0x49, 0x64, 0x22, 0xf4, 0x04, 0x0a, 0x0f, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4e, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x38, 0x0a, 0x06,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x06,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x22, 0xc9, 0x01, 0x0a, 0x11, 0x52,
+	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
+	0x6f, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a,
+	0x0a, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x22, 0xe7, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x75, 0x72,
+	0x6e, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x75, 0x72,
+	0x6e, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x49, 0x64, 0x22, 0xe3, 0x01, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x22, 0x8f, 0x01, 0x0a, 0x18,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x09,
+	0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x09, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x86, 0x01,
+	0x0a, 0x16, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x09, 0x72, 0x65,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x22, 0xb8, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x22, 0x17, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
+	0x69, 0x6f, 0x73, 0x22, 0x84, 0x02, 0x0a, 0x10, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
+	0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x22, 0x0a, 0x09, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x52, 0x4b, 0x45,
+	0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x2a, 0x21,
+	0x0a, 0x0a, 0x52, 0x69, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04,
+	0x43, 0x4c, 0x49, 0x50, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x01, 0x32, 0x8a, 0x0c, 0x0a, 0x10, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x00,
+	0x12, 0x6b, 0x0a, 0x10, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
+	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a,
+	0x10, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
+	0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a,
+	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x30, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2d, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
+	0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a,
+	0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x12, 0x2b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x7b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x68, 0x0a,
+	0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x12, 0x28, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x37,
+	0x5a, 0x35, 0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x75, 0x6d, 0x2d, 0x74, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_portfolio_service_proto_rawDescOnce sync.Once
+	file_portfolio_service_proto_rawDescData = file_portfolio_service_proto_rawDesc
+)
+
+func file_portfolio_service_proto_rawDescGZIP() []byte {
+	file_portfolio_service_proto_rawDescOnce.Do(func() {
+		file_portfolio_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_portfolio_service_proto_rawDescData)
+	})
+	return file_portfolio_service_proto_rawDescData
+}
+
+var file_portfolio_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_portfolio_service_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_portfolio_service_proto_goTypes = []any{
+	(OrderType)(0),                           // 0: portfolioservice.OrderType
+	(RiskAction)(0),                          // 1: portfolioservice.RiskAction
+	(*GenerateOrdersRequest)(nil),            // 2: portfolioservice.GenerateOrdersRequest
+	(*GenerateOrdersResponse)(nil),           // 3: portfolioservice.GenerateOrdersResponse
+	(*Order)(nil),                            // 4: portfolioservice.Order
+	(*GetDesiredPortfolioStateRequest)(nil),  // 5: portfolioservice.GetDesiredPortfolioStateRequest
+	(*PortfolioState)(nil),                   // 6: portfolioservice.PortfolioState
+	(*Position)(nil),                         // 7: portfolioservice.Position
+	(*TriggerRebalanceRequest)(nil),          // 8: portfolioservice.TriggerRebalanceRequest
+	(*TriggerRebalanceResponse)(nil),         // 9: portfolioservice.TriggerRebalanceResponse
+	(*ExecutionPhase)(nil),                   // 10: portfolioservice.ExecutionPhase
+	(*OrderFill)(nil),                        // 11: portfolioservice.OrderFill
+	(*PreviewRebalanceRequest)(nil),          // 12: portfolioservice.PreviewRebalanceRequest
+	(*RebalancePreview)(nil),                 // 13: portfolioservice.RebalancePreview
+	(*PositionChange)(nil),                   // 14: portfolioservice.PositionChange
+	(*UpdateRebalanceScheduleRequest)(nil),   // 15: portfolioservice.UpdateRebalanceScheduleRequest
+	(*UpdateRebalanceScheduleResponse)(nil),  // 16: portfolioservice.UpdateRebalanceScheduleResponse
+	(*GetRebalanceScheduleRequest)(nil),      // 17: portfolioservice.GetRebalanceScheduleRequest
+	(*RebalanceSchedule)(nil),                // 18: portfolioservice.RebalanceSchedule
+	(*PortfolioConfiguration)(nil),           // 19: portfolioservice.PortfolioConfiguration
+	(*OptimizerSettings)(nil),                // 20: portfolioservice.OptimizerSettings
+	(*RebalanceBands)(nil),                   // 21: portfolioservice.RebalanceBands
+	(*RiskRules)(nil),                        // 22: portfolioservice.RiskRules
+	(*RiskLimit)(nil),                        // 23: portfolioservice.RiskLimit
+	(*RiskViolation)(nil),                    // 24: portfolioservice.RiskViolation
+	(*ConfigurePortfolioRequest)(nil),        // 25: portfolioservice.ConfigurePortfolioRequest
+	(*ConfigurePortfolioResponse)(nil),       // 26: portfolioservice.ConfigurePortfolioResponse
+	(*GetPortfolioConfigurationRequest)(nil), // 27: portfolioservice.GetPortfolioConfigurationRequest
+	(*RebalanceRecord)(nil),                  // 28: portfolioservice.RebalanceRecord
+	(*RebalanceApproval)(nil),                // 29: portfolioservice.RebalanceApproval
+	(*ListRebalancesRequest)(nil),            // 30: portfolioservice.ListRebalancesRequest
+	(*ListRebalancesResponse)(nil),           // 31: portfolioservice.ListRebalancesResponse
+	(*RebalanceSummary)(nil),                 // 32: portfolioservice.RebalanceSummary
+	(*GetRebalanceRequest)(nil),              // 33: portfolioservice.GetRebalanceRequest
+	(*ApproveRebalanceRequest)(nil),          // 34: portfolioservice.ApproveRebalanceRequest
+	(*ApproveRebalanceResponse)(nil),         // 35: portfolioservice.ApproveRebalanceResponse
+	(*RejectRebalanceRequest)(nil),           // 36: portfolioservice.RejectRebalanceRequest
+	(*RejectRebalanceResponse)(nil),          // 37: portfolioservice.RejectRebalanceResponse
+	(*CreatePortfolioRequest)(nil),           // 38: portfolioservice.CreatePortfolioRequest
+	(*CreatePortfolioResponse)(nil),          // 39: portfolioservice.CreatePortfolioResponse
+	(*ListPortfoliosRequest)(nil),            // 40: portfolioservice.ListPortfoliosRequest
+	(*ListPortfoliosResponse)(nil),           // 41: portfolioservice.ListPortfoliosResponse
+	(*PortfolioSummary)(nil),                 // 42: portfolioservice.PortfolioSummary
+	nil,                                      // 43: portfolioservice.PortfolioConfiguration.StrategyParametersEntry
+	(*strategy_service.StockSignal)(nil),     // 44: strategyservice.StockSignal
+	(*strategy_service.SignalResponse)(nil),  // 45: strategyservice.SignalResponse
+}
+var file_portfolio_service_proto_depIdxs = []int32{
+	44, // 0: portfolioservice.GenerateOrdersRequest.signals:type_name -> strategyservice.StockSignal
+	4,  // 1: portfolioservice.GenerateOrdersResponse.orders:type_name -> portfolioservice.Order
+	0,  // 2: portfolioservice.Order.type:type_name -> portfolioservice.OrderType
+	7,  // 3: portfolioservice.PortfolioState.positions:type_name -> portfolioservice.Position
+	13, // 4: portfolioservice.TriggerRebalanceResponse.preview:type_name -> portfolioservice.RebalancePreview
+	10, // 5: portfolioservice.TriggerRebalanceResponse.phases:type_name -> portfolioservice.ExecutionPhase
+	4,  // 6: portfolioservice.ExecutionPhase.orders:type_name -> portfolioservice.Order
+	11, // 7: portfolioservice.ExecutionPhase.fills:type_name -> portfolioservice.OrderFill
+	14, // 8: portfolioservice.RebalancePreview.positions:type_name -> portfolioservice.PositionChange
+	4,  // 9: portfolioservice.RebalancePreview.orders:type_name -> portfolioservice.Order
+	24, // 10: portfolioservice.RebalancePreview.violations:type_name -> portfolioservice.RiskViolation
+	43, // 11: portfolioservice.PortfolioConfiguration.strategy_parameters:type_name -> portfolioservice.PortfolioConfiguration.StrategyParametersEntry
+	22, // 12: portfolioservice.PortfolioConfiguration.risk_rules:type_name -> portfolioservice.RiskRules
+	21, // 13: portfolioservice.PortfolioConfiguration.rebalance_bands:type_name -> portfolioservice.RebalanceBands
+	20, // 14: portfolioservice.PortfolioConfiguration.optimizer:type_name -> portfolioservice.OptimizerSettings
+	23, // 15: portfolioservice.RiskRules.max_position_weight:type_name -> portfolioservice.RiskLimit
+	23, // 16: portfolioservice.RiskRules.max_sector_weight:type_name -> portfolioservice.RiskLimit
+	23, // 17: portfolioservice.RiskRules.max_positions:type_name -> portfolioservice.RiskLimit
+	23, // 18: portfolioservice.RiskRules.min_cash_buffer:type_name -> portfolioservice.RiskLimit
+	23, // 19: portfolioservice.RiskRules.max_gross_exposure:type_name -> portfolioservice.RiskLimit
+	23, // 20: portfolioservice.RiskRules.max_turnover:type_name -> portfolioservice.RiskLimit
+	1,  // 21: portfolioservice.RiskLimit.action:type_name -> portfolioservice.RiskAction
+	1,  // 22: portfolioservice.RiskViolation.action:type_name -> portfolioservice.RiskAction
+	19, // 23: portfolioservice.ConfigurePortfolioRequest.configuration:type_name -> portfolioservice.PortfolioConfiguration
+	19, // 24: portfolioservice.ConfigurePortfolioResponse.configuration:type_name -> portfolioservice.PortfolioConfiguration
+	19, // 25: portfolioservice.RebalanceRecord.configuration:type_name -> portfolioservice.PortfolioConfiguration
+	45, // 26: portfolioservice.RebalanceRecord.signals:type_name -> strategyservice.SignalResponse
+	13, // 27: portfolioservice.RebalanceRecord.plan:type_name -> portfolioservice.RebalancePreview
+	10, // 28: portfolioservice.RebalanceRecord.phases:type_name -> portfolioservice.ExecutionPhase
+	6,  // 29: portfolioservice.RebalanceRecord.resulting_state:type_name -> portfolioservice.PortfolioState
+	29, // 30: portfolioservice.RebalanceRecord.approval:type_name -> portfolioservice.RebalanceApproval
+	4,  // 31: portfolioservice.RebalanceApproval.approved_orders:type_name -> portfolioservice.Order
+	32, // 32: portfolioservice.ListRebalancesResponse.rebalances:type_name -> portfolioservice.RebalanceSummary
+	4,  // 33: portfolioservice.ApproveRebalanceRequest.order_overrides:type_name -> portfolioservice.Order
+	28, // 34: portfolioservice.ApproveRebalanceResponse.rebalance:type_name -> portfolioservice.RebalanceRecord
+	28, // 35: portfolioservice.RejectRebalanceResponse.rebalance:type_name -> portfolioservice.RebalanceRecord
+	19, // 36: portfolioservice.CreatePortfolioRequest.configuration:type_name -> portfolioservice.PortfolioConfiguration
+	19, // 37: portfolioservice.CreatePortfolioResponse.configuration:type_name -> portfolioservice.PortfolioConfiguration
+	42, // 38: portfolioservice.ListPortfoliosResponse.portfolios:type_name -> portfolioservice.PortfolioSummary
+	2,  // 39: portfolioservice.PortfolioService.GenerateOrders:input_type -> portfolioservice.GenerateOrdersRequest
+	5,  // 40: portfolioservice.PortfolioService.GetDesiredPortfolioState:input_type -> portfolioservice.GetDesiredPortfolioStateRequest
+	8,  // 41: portfolioservice.PortfolioService.TriggerRebalance:input_type -> portfolioservice.TriggerRebalanceRequest
+	12, // 42: portfolioservice.PortfolioService.PreviewRebalance:input_type -> portfolioservice.PreviewRebalanceRequest
+	30, // 43: portfolioservice.PortfolioService.ListRebalances:input_type -> portfolioservice.ListRebalancesRequest
+	33, // 44: portfolioservice.PortfolioService.GetRebalance:input_type -> portfolioservice.GetRebalanceRequest
+	34, // 45: portfolioservice.PortfolioService.ApproveRebalance:input_type -> portfolioservice.ApproveRebalanceRequest
+	36, // 46: portfolioservice.PortfolioService.RejectRebalance:input_type -> portfolioservice.RejectRebalanceRequest
+	15, // 47: portfolioservice.PortfolioService.UpdateRebalanceSchedule:input_type -> portfolioservice.UpdateRebalanceScheduleRequest
+	17, // 48: portfolioservice.PortfolioService.GetRebalanceSchedule:input_type -> portfolioservice.GetRebalanceScheduleRequest
+	25, // 49: portfolioservice.PortfolioService.ConfigurePortfolio:input_type -> portfolioservice.ConfigurePortfolioRequest
+	27, // 50: portfolioservice.PortfolioService.GetPortfolioConfiguration:input_type -> portfolioservice.GetPortfolioConfigurationRequest
+	38, // 51: portfolioservice.PortfolioService.CreatePortfolio:input_type -> portfolioservice.CreatePortfolioRequest
+	40, // 52: portfolioservice.PortfolioService.ListPortfolios:input_type -> portfolioservice.ListPortfoliosRequest
+	3,  // 53: portfolioservice.PortfolioService.GenerateOrders:output_type -> portfolioservice.GenerateOrdersResponse
+	6,  // 54: portfolioservice.PortfolioService.GetDesiredPortfolioState:output_type -> portfolioservice.PortfolioState
+	9,  // 55: portfolioservice.PortfolioService.TriggerRebalance:output_type -> portfolioservice.TriggerRebalanceResponse
+	13, // 56: portfolioservice.PortfolioService.PreviewRebalance:output_type -> portfolioservice.RebalancePreview
+	31, // 57: portfolioservice.PortfolioService.ListRebalances:output_type -> portfolioservice.ListRebalancesResponse
+	28, // 58: portfolioservice.PortfolioService.GetRebalance:output_type -> portfolioservice.RebalanceRecord
+	35, // 59: portfolioservice.PortfolioService.ApproveRebalance:output_type -> portfolioservice.ApproveRebalanceResponse
+	37, // 60: portfolioservice.PortfolioService.RejectRebalance:output_type -> portfolioservice.RejectRebalanceResponse
+	16, // 61: portfolioservice.PortfolioService.UpdateRebalanceSchedule:output_type -> portfolioservice.UpdateRebalanceScheduleResponse
+	18, // 62: portfolioservice.PortfolioService.GetRebalanceSchedule:output_type -> portfolioservice.RebalanceSchedule
+	26, // 63: portfolioservice.PortfolioService.ConfigurePortfolio:output_type -> portfolioservice.ConfigurePortfolioResponse
+	19, // 64: portfolioservice.PortfolioService.GetPortfolioConfiguration:output_type -> portfolioservice.PortfolioConfiguration
+	39, // 65: portfolioservice.PortfolioService.CreatePortfolio:output_type -> portfolioservice.CreatePortfolioResponse
+	41, // 66: portfolioservice.PortfolioService.ListPortfolios:output_type -> portfolioservice.ListPortfoliosResponse
+	53, // [53:67] is the sub-list for method output_type
+	39, // [39:53] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
+}
+
+func init() { file_portfolio_service_proto_init() }
+func file_portfolio_service_proto_init() {
+	if File_portfolio_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_portfolio_service_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateOrdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateOrdersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
 		file_portfolio_service_proto_msgTypes[3].Exporter = func(v any, i int) any {
 			switch v := v.(*GetDesiredPortfolioStateRequest); i {
@@ -803,8 +3967,92 @@ func file_portfolio_service_proto_init() {
 				return nil
 			}
 		}
-		file_portfolio_service_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*PortfolioState); i {
+		file_portfolio_service_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*PortfolioState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Position); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*TriggerRebalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*TriggerRebalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecutionPhase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*OrderFill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*PreviewRebalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*RebalancePreview); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -815,8 +4063,8 @@ func file_portfolio_service_proto_init() {
 				return nil
 			}
 		}
-		file_portfolio_service_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*Position); i {
+		file_portfolio_service_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*PositionChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -827,8 +4075,8 @@ func file_portfolio_service_proto_init() {
 				return nil
 			}
 		}
-		file_portfolio_service_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*TriggerRebalanceRequest); i {
+		file_portfolio_service_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateRebalanceScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -839,8 +4087,8 @@ func file_portfolio_service_proto_init() {
 				return nil
 			}
 		}
-		file_portfolio_service_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*TriggerRebalanceResponse); i {
+		file_portfolio_service_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateRebalanceScheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -851,8 +4099,8 @@ func file_portfolio_service_proto_init() {
 				return nil
 			}
 		}
-		file_portfolio_service_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateRebalanceScheduleRequest); i {
+		file_portfolio_service_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRebalanceScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -863,8 +4111,296 @@ func file_portfolio_service_proto_init() {
 				return nil
 			}
 		}
-		file_portfolio_service_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateRebalanceScheduleResponse); i {
+		file_portfolio_service_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*RebalanceSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*PortfolioConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*OptimizerSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*RebalanceBands); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*RiskRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*RiskLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*RiskViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigurePortfolioRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigurePortfolioResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPortfolioConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*RebalanceRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*RebalanceApproval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ListRebalancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ListRebalancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*RebalanceSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRebalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*ApproveRebalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*ApproveRebalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*RejectRebalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*RejectRebalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePortfolioRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePortfolioResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPortfoliosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPortfoliosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portfolio_service_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*PortfolioSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -876,13 +4412,14 @@ func file_portfolio_service_proto_init() {
 			}
 		}
 	}
+	file_portfolio_service_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_portfolio_service_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
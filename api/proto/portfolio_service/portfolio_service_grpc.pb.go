@@ -21,10 +21,20 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	PortfolioService_GenerateOrders_FullMethodName           = "/portfolioservice.PortfolioService/GenerateOrders"
-	PortfolioService_GetDesiredPortfolioState_FullMethodName = "/portfolioservice.PortfolioService/GetDesiredPortfolioState"
-	PortfolioService_TriggerRebalance_FullMethodName         = "/portfolioservice.PortfolioService/TriggerRebalance"
-	PortfolioService_UpdateRebalanceSchedule_FullMethodName  = "/portfolioservice.PortfolioService/UpdateRebalanceSchedule"
+	PortfolioService_GenerateOrders_FullMethodName            = "/portfolioservice.PortfolioService/GenerateOrders"
+	PortfolioService_GetDesiredPortfolioState_FullMethodName  = "/portfolioservice.PortfolioService/GetDesiredPortfolioState"
+	PortfolioService_TriggerRebalance_FullMethodName          = "/portfolioservice.PortfolioService/TriggerRebalance"
+	PortfolioService_PreviewRebalance_FullMethodName          = "/portfolioservice.PortfolioService/PreviewRebalance"
+	PortfolioService_ListRebalances_FullMethodName            = "/portfolioservice.PortfolioService/ListRebalances"
+	PortfolioService_GetRebalance_FullMethodName              = "/portfolioservice.PortfolioService/GetRebalance"
+	PortfolioService_ApproveRebalance_FullMethodName          = "/portfolioservice.PortfolioService/ApproveRebalance"
+	PortfolioService_RejectRebalance_FullMethodName           = "/portfolioservice.PortfolioService/RejectRebalance"
+	PortfolioService_UpdateRebalanceSchedule_FullMethodName   = "/portfolioservice.PortfolioService/UpdateRebalanceSchedule"
+	PortfolioService_GetRebalanceSchedule_FullMethodName      = "/portfolioservice.PortfolioService/GetRebalanceSchedule"
+	PortfolioService_ConfigurePortfolio_FullMethodName        = "/portfolioservice.PortfolioService/ConfigurePortfolio"
+	PortfolioService_GetPortfolioConfiguration_FullMethodName = "/portfolioservice.PortfolioService/GetPortfolioConfiguration"
+	PortfolioService_CreatePortfolio_FullMethodName           = "/portfolioservice.PortfolioService/CreatePortfolio"
+	PortfolioService_ListPortfolios_FullMethodName            = "/portfolioservice.PortfolioService/ListPortfolios"
 )
 
 // PortfolioServiceClient is the client API for PortfolioService service.
@@ -34,7 +44,17 @@ type PortfolioServiceClient interface {
 	GenerateOrders(ctx context.Context, in *GenerateOrdersRequest, opts ...grpc.CallOption) (*GenerateOrdersResponse, error)
 	GetDesiredPortfolioState(ctx context.Context, in *GetDesiredPortfolioStateRequest, opts ...grpc.CallOption) (*PortfolioState, error)
 	TriggerRebalance(ctx context.Context, in *TriggerRebalanceRequest, opts ...grpc.CallOption) (*TriggerRebalanceResponse, error)
+	PreviewRebalance(ctx context.Context, in *PreviewRebalanceRequest, opts ...grpc.CallOption) (*RebalancePreview, error)
+	ListRebalances(ctx context.Context, in *ListRebalancesRequest, opts ...grpc.CallOption) (*ListRebalancesResponse, error)
+	GetRebalance(ctx context.Context, in *GetRebalanceRequest, opts ...grpc.CallOption) (*RebalanceRecord, error)
+	ApproveRebalance(ctx context.Context, in *ApproveRebalanceRequest, opts ...grpc.CallOption) (*ApproveRebalanceResponse, error)
+	RejectRebalance(ctx context.Context, in *RejectRebalanceRequest, opts ...grpc.CallOption) (*RejectRebalanceResponse, error)
 	UpdateRebalanceSchedule(ctx context.Context, in *UpdateRebalanceScheduleRequest, opts ...grpc.CallOption) (*UpdateRebalanceScheduleResponse, error)
+	GetRebalanceSchedule(ctx context.Context, in *GetRebalanceScheduleRequest, opts ...grpc.CallOption) (*RebalanceSchedule, error)
+	ConfigurePortfolio(ctx context.Context, in *ConfigurePortfolioRequest, opts ...grpc.CallOption) (*ConfigurePortfolioResponse, error)
+	GetPortfolioConfiguration(ctx context.Context, in *GetPortfolioConfigurationRequest, opts ...grpc.CallOption) (*PortfolioConfiguration, error)
+	CreatePortfolio(ctx context.Context, in *CreatePortfolioRequest, opts ...grpc.CallOption) (*CreatePortfolioResponse, error)
+	ListPortfolios(ctx context.Context, in *ListPortfoliosRequest, opts ...grpc.CallOption) (*ListPortfoliosResponse, error)
 }
 
 type portfolioServiceClient struct {
@@ -75,6 +95,56 @@ func (c *portfolioServiceClient) TriggerRebalance(ctx context.Context, in *Trigg
 	return out, nil
 }
 
+func (c *portfolioServiceClient) PreviewRebalance(ctx context.Context, in *PreviewRebalanceRequest, opts ...grpc.CallOption) (*RebalancePreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebalancePreview)
+	err := c.cc.Invoke(ctx, PortfolioService_PreviewRebalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) ListRebalances(ctx context.Context, in *ListRebalancesRequest, opts ...grpc.CallOption) (*ListRebalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRebalancesResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_ListRebalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetRebalance(ctx context.Context, in *GetRebalanceRequest, opts ...grpc.CallOption) (*RebalanceRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebalanceRecord)
+	err := c.cc.Invoke(ctx, PortfolioService_GetRebalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) ApproveRebalance(ctx context.Context, in *ApproveRebalanceRequest, opts ...grpc.CallOption) (*ApproveRebalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveRebalanceResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_ApproveRebalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) RejectRebalance(ctx context.Context, in *RejectRebalanceRequest, opts ...grpc.CallOption) (*RejectRebalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectRebalanceResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_RejectRebalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *portfolioServiceClient) UpdateRebalanceSchedule(ctx context.Context, in *UpdateRebalanceScheduleRequest, opts ...grpc.CallOption) (*UpdateRebalanceScheduleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateRebalanceScheduleResponse)
@@ -85,6 +155,56 @@ func (c *portfolioServiceClient) UpdateRebalanceSchedule(ctx context.Context, in
 	return out, nil
 }
 
+func (c *portfolioServiceClient) GetRebalanceSchedule(ctx context.Context, in *GetRebalanceScheduleRequest, opts ...grpc.CallOption) (*RebalanceSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebalanceSchedule)
+	err := c.cc.Invoke(ctx, PortfolioService_GetRebalanceSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) ConfigurePortfolio(ctx context.Context, in *ConfigurePortfolioRequest, opts ...grpc.CallOption) (*ConfigurePortfolioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigurePortfolioResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_ConfigurePortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetPortfolioConfiguration(ctx context.Context, in *GetPortfolioConfigurationRequest, opts ...grpc.CallOption) (*PortfolioConfiguration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PortfolioConfiguration)
+	err := c.cc.Invoke(ctx, PortfolioService_GetPortfolioConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) CreatePortfolio(ctx context.Context, in *CreatePortfolioRequest, opts ...grpc.CallOption) (*CreatePortfolioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePortfolioResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_CreatePortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) ListPortfolios(ctx context.Context, in *ListPortfoliosRequest, opts ...grpc.CallOption) (*ListPortfoliosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPortfoliosResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_ListPortfolios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PortfolioServiceServer is the server API for PortfolioService service.
 // All implementations must embed UnimplementedPortfolioServiceServer
 // for forward compatibility
@@ -92,7 +212,17 @@ type PortfolioServiceServer interface {
 	GenerateOrders(context.Context, *GenerateOrdersRequest) (*GenerateOrdersResponse, error)
 	GetDesiredPortfolioState(context.Context, *GetDesiredPortfolioStateRequest) (*PortfolioState, error)
 	TriggerRebalance(context.Context, *TriggerRebalanceRequest) (*TriggerRebalanceResponse, error)
+	PreviewRebalance(context.Context, *PreviewRebalanceRequest) (*RebalancePreview, error)
+	ListRebalances(context.Context, *ListRebalancesRequest) (*ListRebalancesResponse, error)
+	GetRebalance(context.Context, *GetRebalanceRequest) (*RebalanceRecord, error)
+	ApproveRebalance(context.Context, *ApproveRebalanceRequest) (*ApproveRebalanceResponse, error)
+	RejectRebalance(context.Context, *RejectRebalanceRequest) (*RejectRebalanceResponse, error)
 	UpdateRebalanceSchedule(context.Context, *UpdateRebalanceScheduleRequest) (*UpdateRebalanceScheduleResponse, error)
+	GetRebalanceSchedule(context.Context, *GetRebalanceScheduleRequest) (*RebalanceSchedule, error)
+	ConfigurePortfolio(context.Context, *ConfigurePortfolioRequest) (*ConfigurePortfolioResponse, error)
+	GetPortfolioConfiguration(context.Context, *GetPortfolioConfigurationRequest) (*PortfolioConfiguration, error)
+	CreatePortfolio(context.Context, *CreatePortfolioRequest) (*CreatePortfolioResponse, error)
+	ListPortfolios(context.Context, *ListPortfoliosRequest) (*ListPortfoliosResponse, error)
 	mustEmbedUnimplementedPortfolioServiceServer()
 }
 
@@ -109,9 +239,39 @@ func (UnimplementedPortfolioServiceServer) GetDesiredPortfolioState(context.Cont
 func (UnimplementedPortfolioServiceServer) TriggerRebalance(context.Context, *TriggerRebalanceRequest) (*TriggerRebalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerRebalance not implemented")
 }
+func (UnimplementedPortfolioServiceServer) PreviewRebalance(context.Context, *PreviewRebalanceRequest) (*RebalancePreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewRebalance not implemented")
+}
+func (UnimplementedPortfolioServiceServer) ListRebalances(context.Context, *ListRebalancesRequest) (*ListRebalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRebalances not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetRebalance(context.Context, *GetRebalanceRequest) (*RebalanceRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRebalance not implemented")
+}
+func (UnimplementedPortfolioServiceServer) ApproveRebalance(context.Context, *ApproveRebalanceRequest) (*ApproveRebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRebalance not implemented")
+}
+func (UnimplementedPortfolioServiceServer) RejectRebalance(context.Context, *RejectRebalanceRequest) (*RejectRebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRebalance not implemented")
+}
 func (UnimplementedPortfolioServiceServer) UpdateRebalanceSchedule(context.Context, *UpdateRebalanceScheduleRequest) (*UpdateRebalanceScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRebalanceSchedule not implemented")
 }
+func (UnimplementedPortfolioServiceServer) GetRebalanceSchedule(context.Context, *GetRebalanceScheduleRequest) (*RebalanceSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRebalanceSchedule not implemented")
+}
+func (UnimplementedPortfolioServiceServer) ConfigurePortfolio(context.Context, *ConfigurePortfolioRequest) (*ConfigurePortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigurePortfolio not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetPortfolioConfiguration(context.Context, *GetPortfolioConfigurationRequest) (*PortfolioConfiguration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioConfiguration not implemented")
+}
+func (UnimplementedPortfolioServiceServer) CreatePortfolio(context.Context, *CreatePortfolioRequest) (*CreatePortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePortfolio not implemented")
+}
+func (UnimplementedPortfolioServiceServer) ListPortfolios(context.Context, *ListPortfoliosRequest) (*ListPortfoliosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPortfolios not implemented")
+}
 func (UnimplementedPortfolioServiceServer) mustEmbedUnimplementedPortfolioServiceServer() {}
 
 // UnsafePortfolioServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -179,6 +339,96 @@ func _PortfolioService_TriggerRebalance_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PortfolioService_PreviewRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).PreviewRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_PreviewRebalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).PreviewRebalance(ctx, req.(*PreviewRebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_ListRebalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRebalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).ListRebalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_ListRebalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).ListRebalances(ctx, req.(*ListRebalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetRebalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetRebalance(ctx, req.(*GetRebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_ApproveRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).ApproveRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_ApproveRebalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).ApproveRebalance(ctx, req.(*ApproveRebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_RejectRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).RejectRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_RejectRebalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).RejectRebalance(ctx, req.(*RejectRebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PortfolioService_UpdateRebalanceSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateRebalanceScheduleRequest)
 	if err := dec(in); err != nil {
@@ -197,6 +447,96 @@ func _PortfolioService_UpdateRebalanceSchedule_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PortfolioService_GetRebalanceSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRebalanceScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetRebalanceSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetRebalanceSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetRebalanceSchedule(ctx, req.(*GetRebalanceScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_ConfigurePortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigurePortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).ConfigurePortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_ConfigurePortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).ConfigurePortfolio(ctx, req.(*ConfigurePortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetPortfolioConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetPortfolioConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetPortfolioConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetPortfolioConfiguration(ctx, req.(*GetPortfolioConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_CreatePortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).CreatePortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_CreatePortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).CreatePortfolio(ctx, req.(*CreatePortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_ListPortfolios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPortfoliosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).ListPortfolios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_ListPortfolios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).ListPortfolios(ctx, req.(*ListPortfoliosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PortfolioService_ServiceDesc is the grpc.ServiceDesc for PortfolioService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -216,10 +556,50 @@ var PortfolioService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TriggerRebalance",
 			Handler:    _PortfolioService_TriggerRebalance_Handler,
 		},
+		{
+			MethodName: "PreviewRebalance",
+			Handler:    _PortfolioService_PreviewRebalance_Handler,
+		},
+		{
+			MethodName: "ListRebalances",
+			Handler:    _PortfolioService_ListRebalances_Handler,
+		},
+		{
+			MethodName: "GetRebalance",
+			Handler:    _PortfolioService_GetRebalance_Handler,
+		},
+		{
+			MethodName: "ApproveRebalance",
+			Handler:    _PortfolioService_ApproveRebalance_Handler,
+		},
+		{
+			MethodName: "RejectRebalance",
+			Handler:    _PortfolioService_RejectRebalance_Handler,
+		},
 		{
 			MethodName: "UpdateRebalanceSchedule",
 			Handler:    _PortfolioService_UpdateRebalanceSchedule_Handler,
 		},
+		{
+			MethodName: "GetRebalanceSchedule",
+			Handler:    _PortfolioService_GetRebalanceSchedule_Handler,
+		},
+		{
+			MethodName: "ConfigurePortfolio",
+			Handler:    _PortfolioService_ConfigurePortfolio_Handler,
+		},
+		{
+			MethodName: "GetPortfolioConfiguration",
+			Handler:    _PortfolioService_GetPortfolioConfiguration_Handler,
+		},
+		{
+			MethodName: "CreatePortfolio",
+			Handler:    _PortfolioService_CreatePortfolio_Handler,
+		},
+		{
+			MethodName: "ListPortfolios",
+			Handler:    _PortfolioService_ListPortfolios_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "portfolio_service.proto",
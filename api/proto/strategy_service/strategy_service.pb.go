@@ -22,6 +22,110 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MarketRegime int32
+
+const (
+	MarketRegime_BULL    MarketRegime = 0
+	MarketRegime_BEAR    MarketRegime = 1
+	MarketRegime_NEUTRAL MarketRegime = 2
+)
+
+// Enum value maps for MarketRegime.
+var (
+	MarketRegime_name = map[int32]string{
+		0: "BULL",
+		1: "BEAR",
+		2: "NEUTRAL",
+	}
+	MarketRegime_value = map[string]int32{
+		"BULL":    0,
+		"BEAR":    1,
+		"NEUTRAL": 2,
+	}
+)
+
+func (x MarketRegime) Enum() *MarketRegime {
+	p := new(MarketRegime)
+	*p = x
+	return p
+}
+
+func (x MarketRegime) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketRegime) Descriptor() protoreflect.EnumDescriptor {
+	return file_strategy_service_proto_enumTypes[0].Descriptor()
+}
+
+func (MarketRegime) Type() protoreflect.EnumType {
+	return &file_strategy_service_proto_enumTypes[0]
+}
+
+func (x MarketRegime) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketRegime.Descriptor instead.
+func (MarketRegime) EnumDescriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{0}
+}
+
+// What a strategy did with its signals in the market regime, set with the bullAction,
+// neutralAction and bearAction parameters (full, reduced, noNewBuys or cash).
+type RegimeAction int32
+
+const (
+	RegimeAction_FULL        RegimeAction = 0
+	RegimeAction_REDUCED     RegimeAction = 1 // Invested at target_exposure
+	RegimeAction_NO_NEW_BUYS RegimeAction = 2 // BUY signals downgraded to HOLD
+	RegimeAction_CASH        RegimeAction = 3 // No signals, every position exits
+)
+
+// Enum value maps for RegimeAction.
+var (
+	RegimeAction_name = map[int32]string{
+		0: "FULL",
+		1: "REDUCED",
+		2: "NO_NEW_BUYS",
+		3: "CASH",
+	}
+	RegimeAction_value = map[string]int32{
+		"FULL":        0,
+		"REDUCED":     1,
+		"NO_NEW_BUYS": 2,
+		"CASH":        3,
+	}
+)
+
+func (x RegimeAction) Enum() *RegimeAction {
+	p := new(RegimeAction)
+	*p = x
+	return p
+}
+
+func (x RegimeAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegimeAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_strategy_service_proto_enumTypes[1].Descriptor()
+}
+
+func (RegimeAction) Type() protoreflect.EnumType {
+	return &file_strategy_service_proto_enumTypes[1]
+}
+
+func (x RegimeAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegimeAction.Descriptor instead.
+func (RegimeAction) EnumDescriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{1}
+}
+
+// HOLD keeps an existing position at its current quantity but does not open a new one.
 type SignalType int32
 
 const (
@@ -55,11 +159,11 @@ func (x SignalType) String() string {
 }
 
 func (SignalType) Descriptor() protoreflect.EnumDescriptor {
-	return file_strategy_service_proto_enumTypes[0].Descriptor()
+	return file_strategy_service_proto_enumTypes[2].Descriptor()
 }
 
 func (SignalType) Type() protoreflect.EnumType {
-	return &file_strategy_service_proto_enumTypes[0]
+	return &file_strategy_service_proto_enumTypes[2]
 }
 
 func (x SignalType) Number() protoreflect.EnumNumber {
@@ -68,7 +172,7 @@ func (x SignalType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SignalType.Descriptor instead.
 func (SignalType) EnumDescriptor() ([]byte, []int) {
-	return file_strategy_service_proto_rawDescGZIP(), []int{0}
+	return file_strategy_service_proto_rawDescGZIP(), []int{2}
 }
 
 type SignalRequest struct {
@@ -76,11 +180,13 @@ type SignalRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Symbols     []string `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
-	StartDate   string   `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate     string   `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Interval    string   `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"` // 1d, 1wk, 1mo
-	MarketIndex string   `protobuf:"bytes,5,opt,name=market_index,json=marketIndex,proto3" json:"market_index,omitempty"`
+	Symbols            []string          `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	StartDate          string            `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate            string            `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Interval           string            `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"` // 1d, 1wk, 1mo
+	MarketIndex        string            `protobuf:"bytes,5,opt,name=market_index,json=marketIndex,proto3" json:"market_index,omitempty"`
+	StrategyName       string            `protobuf:"bytes,6,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`                                                                                                           // Defaults to "momentum"
+	StrategyParameters map[string]string `protobuf:"bytes,7,rep,name=strategy_parameters,json=strategyParameters,proto3" json:"strategy_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Runs a separate instance with these parameters instead of the configured one
 }
 
 func (x *SignalRequest) Reset() {
@@ -150,12 +256,33 @@ func (x *SignalRequest) GetMarketIndex() string {
 	return ""
 }
 
+func (x *SignalRequest) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *SignalRequest) GetStrategyParameters() map[string]string {
+	if x != nil {
+		return x.StrategyParameters
+	}
+	return nil
+}
+
 type SignalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signals []*StockSignal `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty"`
+	Signals          []*StockSignal      `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty"`
+	Diagnostics      []*SignalDiagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	MarketRegime     MarketRegime        `protobuf:"varint,3,opt,name=market_regime,json=marketRegime,proto3,enum=strategyservice.MarketRegime" json:"market_regime,omitempty"`
+	RunId            string              `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	RegimeConfidence float64             `protobuf:"fixed64,5,opt,name=regime_confidence,json=regimeConfidence,proto3" json:"regime_confidence,omitempty"`                      // 0 to 1
+	RegimeSignals    []*RegimeSignal     `protobuf:"bytes,6,rep,name=regime_signals,json=regimeSignals,proto3" json:"regime_signals,omitempty"`                                 // Readings of the regime models behind market_regime
+	RegimeAction     RegimeAction        `protobuf:"varint,7,opt,name=regime_action,json=regimeAction,proto3,enum=strategyservice.RegimeAction" json:"regime_action,omitempty"` // Action applied for the regime
+	TargetExposure   *float64            `protobuf:"fixed64,8,opt,name=target_exposure,json=targetExposure,proto3,oneof" json:"target_exposure,omitempty"`                      // Fraction of the portfolio to invest in the signals, fully invested when unset
 }
 
 func (x *SignalResponse) Reset() {
@@ -197,20 +324,68 @@ func (x *SignalResponse) GetSignals() []*StockSignal {
 	return nil
 }
 
-type StockSignal struct {
+func (x *SignalResponse) GetDiagnostics() []*SignalDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *SignalResponse) GetMarketRegime() MarketRegime {
+	if x != nil {
+		return x.MarketRegime
+	}
+	return MarketRegime_BULL
+}
+
+func (x *SignalResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *SignalResponse) GetRegimeConfidence() float64 {
+	if x != nil {
+		return x.RegimeConfidence
+	}
+	return 0
+}
+
+func (x *SignalResponse) GetRegimeSignals() []*RegimeSignal {
+	if x != nil {
+		return x.RegimeSignals
+	}
+	return nil
+}
+
+func (x *SignalResponse) GetRegimeAction() RegimeAction {
+	if x != nil {
+		return x.RegimeAction
+	}
+	return RegimeAction_FULL
+}
+
+func (x *SignalResponse) GetTargetExposure() float64 {
+	if x != nil && x.TargetExposure != nil {
+		return *x.TargetExposure
+	}
+	return 0
+}
+
+type SignalDiagnosticsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Symbol        string     `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Signal        SignalType `protobuf:"varint,2,opt,name=signal,proto3,enum=strategyservice.SignalType" json:"signal,omitempty"`
-	RiskUnit      float64    `protobuf:"fixed64,3,opt,name=risk_unit,json=riskUnit,proto3" json:"risk_unit,omitempty"`
-	MomentumScore float64    `protobuf:"fixed64,4,opt,name=momentum_score,json=momentumScore,proto3" json:"momentum_score,omitempty"`
-	CurrentPrice  float64    `protobuf:"fixed64,5,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	MarketRegime     MarketRegime        `protobuf:"varint,1,opt,name=market_regime,json=marketRegime,proto3,enum=strategyservice.MarketRegime" json:"market_regime,omitempty"`
+	Diagnostics      []*SignalDiagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	RegimeConfidence float64             `protobuf:"fixed64,3,opt,name=regime_confidence,json=regimeConfidence,proto3" json:"regime_confidence,omitempty"`
+	RegimeSignals    []*RegimeSignal     `protobuf:"bytes,4,rep,name=regime_signals,json=regimeSignals,proto3" json:"regime_signals,omitempty"`
 }
 
-func (x *StockSignal) Reset() {
-	*x = StockSignal{}
+func (x *SignalDiagnosticsResponse) Reset() {
+	*x = SignalDiagnosticsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_strategy_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -218,13 +393,13 @@ func (x *StockSignal) Reset() {
 	}
 }
 
-func (x *StockSignal) String() string {
+func (x *SignalDiagnosticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StockSignal) ProtoMessage() {}
+func (*SignalDiagnosticsResponse) ProtoMessage() {}
 
-func (x *StockSignal) ProtoReflect() protoreflect.Message {
+func (x *SignalDiagnosticsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_strategy_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -236,57 +411,52 @@ func (x *StockSignal) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StockSignal.ProtoReflect.Descriptor instead.
-func (*StockSignal) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignalDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*SignalDiagnosticsResponse) Descriptor() ([]byte, []int) {
 	return file_strategy_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *StockSignal) GetSymbol() string {
-	if x != nil {
-		return x.Symbol
-	}
-	return ""
-}
-
-func (x *StockSignal) GetSignal() SignalType {
+func (x *SignalDiagnosticsResponse) GetMarketRegime() MarketRegime {
 	if x != nil {
-		return x.Signal
+		return x.MarketRegime
 	}
-	return SignalType_HOLD
+	return MarketRegime_BULL
 }
 
-func (x *StockSignal) GetRiskUnit() float64 {
+func (x *SignalDiagnosticsResponse) GetDiagnostics() []*SignalDiagnostic {
 	if x != nil {
-		return x.RiskUnit
+		return x.Diagnostics
 	}
-	return 0
+	return nil
 }
 
-func (x *StockSignal) GetMomentumScore() float64 {
+func (x *SignalDiagnosticsResponse) GetRegimeConfidence() float64 {
 	if x != nil {
-		return x.MomentumScore
+		return x.RegimeConfidence
 	}
 	return 0
 }
 
-func (x *StockSignal) GetCurrentPrice() float64 {
+func (x *SignalDiagnosticsResponse) GetRegimeSignals() []*RegimeSignal {
 	if x != nil {
-		return x.CurrentPrice
+		return x.RegimeSignals
 	}
-	return 0
+	return nil
 }
 
-type ConfigureStrategyRequest struct {
+type RegimeSignal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StrategyName string            `protobuf:"bytes,1,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
-	Parameters   map[string]string `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Model      string       `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"` // e.g. ma, maSlope, breadth, volatility, drawdown, composite
+	Regime     MarketRegime `protobuf:"varint,2,opt,name=regime,proto3,enum=strategyservice.MarketRegime" json:"regime,omitempty"`
+	Confidence float64      `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Value      float64      `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"` // The model's reading, e.g. the fraction of stocks above their moving average
 }
 
-func (x *ConfigureStrategyRequest) Reset() {
-	*x = ConfigureStrategyRequest{}
+func (x *RegimeSignal) Reset() {
+	*x = RegimeSignal{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_strategy_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -294,13 +464,13 @@ func (x *ConfigureStrategyRequest) Reset() {
 	}
 }
 
-func (x *ConfigureStrategyRequest) String() string {
+func (x *RegimeSignal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigureStrategyRequest) ProtoMessage() {}
+func (*RegimeSignal) ProtoMessage() {}
 
-func (x *ConfigureStrategyRequest) ProtoReflect() protoreflect.Message {
+func (x *RegimeSignal) ProtoReflect() protoreflect.Message {
 	mi := &file_strategy_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -312,36 +482,61 @@ func (x *ConfigureStrategyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigureStrategyRequest.ProtoReflect.Descriptor instead.
-func (*ConfigureStrategyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegimeSignal.ProtoReflect.Descriptor instead.
+func (*RegimeSignal) Descriptor() ([]byte, []int) {
 	return file_strategy_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ConfigureStrategyRequest) GetStrategyName() string {
+func (x *RegimeSignal) GetModel() string {
 	if x != nil {
-		return x.StrategyName
+		return x.Model
 	}
 	return ""
 }
 
-func (x *ConfigureStrategyRequest) GetParameters() map[string]string {
+func (x *RegimeSignal) GetRegime() MarketRegime {
 	if x != nil {
-		return x.Parameters
+		return x.Regime
 	}
-	return nil
+	return MarketRegime_BULL
 }
 
-type ConfigureStrategyResponse struct {
+func (x *RegimeSignal) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *RegimeSignal) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SignalDiagnostic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Symbol        string          `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	MomentumSlope float64         `protobuf:"fixed64,2,opt,name=momentum_slope,json=momentumSlope,proto3" json:"momentum_slope,omitempty"` // Annualized slope of the log-price regression
+	RSquared      float64         `protobuf:"fixed64,3,opt,name=r_squared,json=rSquared,proto3" json:"r_squared,omitempty"`
+	MomentumScore float64         `protobuf:"fixed64,4,opt,name=momentum_score,json=momentumScore,proto3" json:"momentum_score,omitempty"`
+	Atr           float64         `protobuf:"fixed64,5,opt,name=atr,proto3" json:"atr,omitempty"`
+	MaDistance    float64         `protobuf:"fixed64,6,opt,name=ma_distance,json=maDistance,proto3" json:"ma_distance,omitempty"` // (price - MA) / MA
+	HasLargeGap   bool            `protobuf:"varint,7,opt,name=has_large_gap,json=hasLargeGap,proto3" json:"has_large_gap,omitempty"`
+	Rank          int32           `protobuf:"varint,8,opt,name=rank,proto3" json:"rank,omitempty"` // 1-based momentum rank among qualified stocks, 0 if disqualified
+	Selected      bool            `protobuf:"varint,9,opt,name=selected,proto3" json:"selected,omitempty"`
+	Filters       []*FilterResult `protobuf:"bytes,10,rep,name=filters,proto3" json:"filters,omitempty"`
+	MarketRegime  MarketRegime    `protobuf:"varint,11,opt,name=market_regime,json=marketRegime,proto3,enum=strategyservice.MarketRegime" json:"market_regime,omitempty"`
+	Sector        string          `protobuf:"bytes,12,opt,name=sector,proto3" json:"sector,omitempty"`
+	SectorRank    int32           `protobuf:"varint,13,opt,name=sector_rank,json=sectorRank,proto3" json:"sector_rank,omitempty"` // 1-based rank among qualified stocks of the same sector, 0 if disqualified
 }
 
-func (x *ConfigureStrategyResponse) Reset() {
-	*x = ConfigureStrategyResponse{}
+func (x *SignalDiagnostic) Reset() {
+	*x = SignalDiagnostic{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_strategy_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -349,13 +544,13 @@ func (x *ConfigureStrategyResponse) Reset() {
 	}
 }
 
-func (x *ConfigureStrategyResponse) String() string {
+func (x *SignalDiagnostic) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigureStrategyResponse) ProtoMessage() {}
+func (*SignalDiagnostic) ProtoMessage() {}
 
-func (x *ConfigureStrategyResponse) ProtoReflect() protoreflect.Message {
+func (x *SignalDiagnostic) ProtoReflect() protoreflect.Message {
 	mi := &file_strategy_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -367,97 +562,130 @@ func (x *ConfigureStrategyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigureStrategyResponse.ProtoReflect.Descriptor instead.
-func (*ConfigureStrategyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignalDiagnostic.ProtoReflect.Descriptor instead.
+func (*SignalDiagnostic) Descriptor() ([]byte, []int) {
 	return file_strategy_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ConfigureStrategyResponse) GetSuccess() bool {
+func (x *SignalDiagnostic) GetSymbol() string {
 	if x != nil {
-		return x.Success
+		return x.Symbol
 	}
-	return false
+	return ""
 }
 
-func (x *ConfigureStrategyResponse) GetMessage() string {
+func (x *SignalDiagnostic) GetMomentumSlope() float64 {
 	if x != nil {
-		return x.Message
+		return x.MomentumSlope
 	}
-	return ""
+	return 0
 }
 
-type GetStrategyParametersRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SignalDiagnostic) GetRSquared() float64 {
+	if x != nil {
+		return x.RSquared
+	}
+	return 0
+}
 
-	StrategyName string `protobuf:"bytes,1,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
+func (x *SignalDiagnostic) GetMomentumScore() float64 {
+	if x != nil {
+		return x.MomentumScore
+	}
+	return 0
 }
 
-func (x *GetStrategyParametersRequest) Reset() {
-	*x = GetStrategyParametersRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_strategy_service_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SignalDiagnostic) GetAtr() float64 {
+	if x != nil {
+		return x.Atr
 	}
+	return 0
 }
 
-func (x *GetStrategyParametersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SignalDiagnostic) GetMaDistance() float64 {
+	if x != nil {
+		return x.MaDistance
+	}
+	return 0
 }
 
-func (*GetStrategyParametersRequest) ProtoMessage() {}
+func (x *SignalDiagnostic) GetHasLargeGap() bool {
+	if x != nil {
+		return x.HasLargeGap
+	}
+	return false
+}
 
-func (x *GetStrategyParametersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_strategy_service_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SignalDiagnostic) GetRank() int32 {
+	if x != nil {
+		return x.Rank
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetStrategyParametersRequest.ProtoReflect.Descriptor instead.
-func (*GetStrategyParametersRequest) Descriptor() ([]byte, []int) {
-	return file_strategy_service_proto_rawDescGZIP(), []int{5}
+func (x *SignalDiagnostic) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
 }
 
-func (x *GetStrategyParametersRequest) GetStrategyName() string {
+func (x *SignalDiagnostic) GetFilters() []*FilterResult {
 	if x != nil {
-		return x.StrategyName
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SignalDiagnostic) GetMarketRegime() MarketRegime {
+	if x != nil {
+		return x.MarketRegime
+	}
+	return MarketRegime_BULL
+}
+
+func (x *SignalDiagnostic) GetSector() string {
+	if x != nil {
+		return x.Sector
 	}
 	return ""
 }
 
-type GetStrategyParametersResponse struct {
+func (x *SignalDiagnostic) GetSectorRank() int32 {
+	if x != nil {
+		return x.SectorRank
+	}
+	return 0
+}
+
+type FilterResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Parameters map[string]string `protobuf:"bytes,1,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed     bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReasonCode string `protobuf:"bytes,4,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"` // Machine-readable code, empty when the filter passed
 }
 
-func (x *GetStrategyParametersResponse) Reset() {
-	*x = GetStrategyParametersResponse{}
+func (x *FilterResult) Reset() {
+	*x = FilterResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_strategy_service_proto_msgTypes[6]
+		mi := &file_strategy_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStrategyParametersResponse) String() string {
+func (x *FilterResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStrategyParametersResponse) ProtoMessage() {}
+func (*FilterResult) ProtoMessage() {}
 
-func (x *GetStrategyParametersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_strategy_service_proto_msgTypes[6]
+func (x *FilterResult) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,16 +696,929 @@ func (x *GetStrategyParametersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStrategyParametersResponse.ProtoReflect.Descriptor instead.
-func (*GetStrategyParametersResponse) Descriptor() ([]byte, []int) {
-	return file_strategy_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use FilterResult.ProtoReflect.Descriptor instead.
+func (*FilterResult) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetStrategyParametersResponse) GetParameters() map[string]string {
+func (x *FilterResult) GetName() string {
 	if x != nil {
-		return x.Parameters
+		return x.Name
 	}
-	return nil
+	return ""
+}
+
+func (x *FilterResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *FilterResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FilterResult) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+type StockSignal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol        string               `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Signal        SignalType           `protobuf:"varint,2,opt,name=signal,proto3,enum=strategyservice.SignalType" json:"signal,omitempty"`
+	RiskUnit      float64              `protobuf:"fixed64,3,opt,name=risk_unit,json=riskUnit,proto3" json:"risk_unit,omitempty"`
+	MomentumScore float64              `protobuf:"fixed64,4,opt,name=momentum_score,json=momentumScore,proto3" json:"momentum_score,omitempty"`
+	CurrentPrice  float64              `protobuf:"fixed64,5,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	Attributions  []*SignalAttribution `protobuf:"bytes,6,rep,name=attributions,proto3" json:"attributions,omitempty"`                 // Per-strategy contributions for blended signals
+	RiskMethod    string               `protobuf:"bytes,7,opt,name=risk_method,json=riskMethod,proto3" json:"risk_method,omitempty"`   // Method that produced risk_unit, e.g. atr, atrPercent, inverseVolatility
+	RiskMetric    float64              `protobuf:"fixed64,8,opt,name=risk_metric,json=riskMetric,proto3" json:"risk_metric,omitempty"` // Raw input of the risk method: ATR, ATR as a fraction of price, volatility or stop distance
+}
+
+func (x *StockSignal) Reset() {
+	*x = StockSignal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StockSignal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockSignal) ProtoMessage() {}
+
+func (x *StockSignal) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockSignal.ProtoReflect.Descriptor instead.
+func (*StockSignal) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StockSignal) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *StockSignal) GetSignal() SignalType {
+	if x != nil {
+		return x.Signal
+	}
+	return SignalType_HOLD
+}
+
+func (x *StockSignal) GetRiskUnit() float64 {
+	if x != nil {
+		return x.RiskUnit
+	}
+	return 0
+}
+
+func (x *StockSignal) GetMomentumScore() float64 {
+	if x != nil {
+		return x.MomentumScore
+	}
+	return 0
+}
+
+func (x *StockSignal) GetCurrentPrice() float64 {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return 0
+}
+
+func (x *StockSignal) GetAttributions() []*SignalAttribution {
+	if x != nil {
+		return x.Attributions
+	}
+	return nil
+}
+
+func (x *StockSignal) GetRiskMethod() string {
+	if x != nil {
+		return x.RiskMethod
+	}
+	return ""
+}
+
+func (x *StockSignal) GetRiskMetric() float64 {
+	if x != nil {
+		return x.RiskMetric
+	}
+	return 0
+}
+
+type SignalAttribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StrategyName    string     `protobuf:"bytes,1,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
+	Signal          SignalType `protobuf:"varint,2,opt,name=signal,proto3,enum=strategyservice.SignalType" json:"signal,omitempty"`
+	Score           float64    `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	NormalizedScore float64    `protobuf:"fixed64,4,opt,name=normalized_score,json=normalizedScore,proto3" json:"normalized_score,omitempty"`
+	Weight          float64    `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	RiskUnit        float64    `protobuf:"fixed64,6,opt,name=risk_unit,json=riskUnit,proto3" json:"risk_unit,omitempty"`
+}
+
+func (x *SignalAttribution) Reset() {
+	*x = SignalAttribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalAttribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalAttribution) ProtoMessage() {}
+
+func (x *SignalAttribution) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalAttribution.ProtoReflect.Descriptor instead.
+func (*SignalAttribution) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SignalAttribution) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *SignalAttribution) GetSignal() SignalType {
+	if x != nil {
+		return x.Signal
+	}
+	return SignalType_HOLD
+}
+
+func (x *SignalAttribution) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SignalAttribution) GetNormalizedScore() float64 {
+	if x != nil {
+		return x.NormalizedScore
+	}
+	return 0
+}
+
+func (x *SignalAttribution) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SignalAttribution) GetRiskUnit() float64 {
+	if x != nil {
+		return x.RiskUnit
+	}
+	return 0
+}
+
+type ConfigureStrategyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StrategyName string            `protobuf:"bytes,1,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
+	Parameters   map[string]string `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ConfigureStrategyRequest) Reset() {
+	*x = ConfigureStrategyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigureStrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureStrategyRequest) ProtoMessage() {}
+
+func (x *ConfigureStrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureStrategyRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureStrategyRequest) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConfigureStrategyRequest) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *ConfigureStrategyRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type ConfigureStrategyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ConfigureStrategyResponse) Reset() {
+	*x = ConfigureStrategyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigureStrategyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureStrategyResponse) ProtoMessage() {}
+
+func (x *ConfigureStrategyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureStrategyResponse.ProtoReflect.Descriptor instead.
+func (*ConfigureStrategyResponse) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConfigureStrategyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfigureStrategyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Checks parameters and a universe against a strategy without configuring it or generating signals.
+type ValidateStrategyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StrategyName string            `protobuf:"bytes,1,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
+	Parameters   map[string]string `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Applied over the configured parameters, as in SignalRequest.strategy_parameters
+	Symbols      []string          `protobuf:"bytes,3,rep,name=symbols,proto3" json:"symbols,omitempty"`                                                                                               // Universe to trade, empty for the strategy's default universe
+}
+
+func (x *ValidateStrategyRequest) Reset() {
+	*x = ValidateStrategyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateStrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateStrategyRequest) ProtoMessage() {}
+
+func (x *ValidateStrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateStrategyRequest.ProtoReflect.Descriptor instead.
+func (*ValidateStrategyRequest) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ValidateStrategyRequest) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *ValidateStrategyRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *ValidateStrategyRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+type ValidateStrategyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Universe []string `protobuf:"bytes,1,rep,name=universe,proto3" json:"universe,omitempty"` // Symbols the strategy would score
+}
+
+func (x *ValidateStrategyResponse) Reset() {
+	*x = ValidateStrategyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateStrategyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateStrategyResponse) ProtoMessage() {}
+
+func (x *ValidateStrategyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateStrategyResponse.ProtoReflect.Descriptor instead.
+func (*ValidateStrategyResponse) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValidateStrategyResponse) GetUniverse() []string {
+	if x != nil {
+		return x.Universe
+	}
+	return nil
+}
+
+type GetStrategyParametersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StrategyName string `protobuf:"bytes,1,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
+}
+
+func (x *GetStrategyParametersRequest) Reset() {
+	*x = GetStrategyParametersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStrategyParametersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrategyParametersRequest) ProtoMessage() {}
+
+func (x *GetStrategyParametersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrategyParametersRequest.ProtoReflect.Descriptor instead.
+func (*GetStrategyParametersRequest) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetStrategyParametersRequest) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+type GetStrategyParametersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parameters map[string]string `protobuf:"bytes,1,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Schema     []*ParameterSpec  `protobuf:"bytes,2,rep,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (x *GetStrategyParametersResponse) Reset() {
+	*x = GetStrategyParametersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStrategyParametersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrategyParametersResponse) ProtoMessage() {}
+
+func (x *GetStrategyParametersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrategyParametersResponse.ProtoReflect.Descriptor instead.
+func (*GetStrategyParametersResponse) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetStrategyParametersResponse) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *GetStrategyParametersResponse) GetSchema() []*ParameterSpec {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type ParameterSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type         string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // int, float, string or list
+	DefaultValue string  `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Min          float64 `protobuf:"fixed64,4,opt,name=min,proto3" json:"min,omitempty"`
+	Max          float64 `protobuf:"fixed64,5,opt,name=max,proto3" json:"max,omitempty"`
+	Description  string  `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ParameterSpec) Reset() {
+	*x = ParameterSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParameterSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterSpec) ProtoMessage() {}
+
+func (x *ParameterSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterSpec.ProtoReflect.Descriptor instead.
+func (*ParameterSpec) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ParameterSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParameterSpec) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ParameterSpec) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *ParameterSpec) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *ParameterSpec) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ParameterSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SignalRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId        string            `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	StrategyName string            `protobuf:"bytes,2,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
+	Parameters   map[string]string `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Symbols      []string          `protobuf:"bytes,4,rep,name=symbols,proto3" json:"symbols,omitempty"` // Symbols scored, the strategy's default universe when the request named none
+	StartDate    string            `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate      string            `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Interval     string            `protobuf:"bytes,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	MarketIndex  string            `protobuf:"bytes,8,opt,name=market_index,json=marketIndex,proto3" json:"market_index,omitempty"`
+	CreatedAt    string            `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC 3339
+	Response     *SignalResponse   `protobuf:"bytes,10,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *SignalRun) Reset() {
+	*x = SignalRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalRun) ProtoMessage() {}
+
+func (x *SignalRun) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalRun.ProtoReflect.Descriptor instead.
+func (*SignalRun) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SignalRun) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *SignalRun) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *SignalRun) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *SignalRun) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *SignalRun) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SignalRun) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *SignalRun) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *SignalRun) GetMarketIndex() string {
+	if x != nil {
+		return x.MarketIndex
+	}
+	return ""
+}
+
+func (x *SignalRun) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SignalRun) GetResponse() *SignalResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ListSignalRunsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StrategyName string `protobuf:"bytes,1,opt,name=strategy_name,json=strategyName,proto3" json:"strategy_name,omitempty"`
+	Symbol       string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`                     // Only runs that produced a signal for this symbol
+	FromDate     string `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // Inclusive, YYYY-MM-DD, applied to the run time
+	ToDate       string `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // Inclusive, YYYY-MM-DD, applied to the run time
+	Limit        int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                      // Defaults to 100
+}
+
+func (x *ListSignalRunsRequest) Reset() {
+	*x = ListSignalRunsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSignalRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSignalRunsRequest) ProtoMessage() {}
+
+func (x *ListSignalRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSignalRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListSignalRunsRequest) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListSignalRunsRequest) GetStrategyName() string {
+	if x != nil {
+		return x.StrategyName
+	}
+	return ""
+}
+
+func (x *ListSignalRunsRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ListSignalRunsRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ListSignalRunsRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *ListSignalRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSignalRunsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Runs []*SignalRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (x *ListSignalRunsResponse) Reset() {
+	*x = ListSignalRunsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSignalRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSignalRunsResponse) ProtoMessage() {}
+
+func (x *ListSignalRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSignalRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListSignalRunsResponse) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListSignalRunsResponse) GetRuns() []*SignalRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type GetSignalRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+}
+
+func (x *GetSignalRunRequest) Reset() {
+	*x = GetSignalRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSignalRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignalRunRequest) ProtoMessage() {}
+
+func (x *GetSignalRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignalRunRequest.ProtoReflect.Descriptor instead.
+func (*GetSignalRunRequest) Descriptor() ([]byte, []int) {
+	return file_strategy_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetSignalRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
 }
 
 var File_strategy_service_proto protoreflect.FileDescriptor
@@ -485,7 +1626,7 @@ var File_strategy_service_proto protoreflect.FileDescriptor
 var file_strategy_service_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x0d, 0x53, 0x69,
+	0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xf7, 0x02, 0x0a, 0x0d, 0x53, 0x69,
 	0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
 	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79,
 	0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64,
@@ -495,87 +1636,323 @@ var file_strategy_service_proto_rawDesc = []byte{
 	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
 	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x48,
-	0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x36, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52,
-	0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x6f,
-	0x63, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x12, 0x33, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x6e,
-	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x69, 0x73, 0x6b, 0x55, 0x6e,
-	0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x75, 0x6d, 0x5f, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x6f, 0x6d, 0x65,
-	0x6e, 0x74, 0x75, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xd9,
-	0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x59, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4f, 0x0a, 0x19, 0x43, 0x6f,
+	0x09, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x67, 0x0a, 0x13, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x45, 0x0a, 0x17,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xe1, 0x03, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x43,
+	0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x65,
+	0x67, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x11, 0x72, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69, 0x6d,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72,
+	0x65, 0x67, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x52, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x73, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6d,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x65,
+	0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x22, 0x97, 0x02, 0x0a, 0x19, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x72, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x72, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69, 0x6d,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72,
+	0x65, 0x67, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x52, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x73, 0x22, 0x91, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd2, 0x03, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x75, 0x6d, 0x5f, 0x73,
+	0x6c, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x6f, 0x6d, 0x65,
+	0x6e, 0x74, 0x75, 0x6d, 0x53, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x5f, 0x73,
+	0x71, 0x75, 0x61, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x53,
+	0x71, 0x75, 0x61, 0x72, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74,
+	0x75, 0x6d, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x75, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x74, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x74, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x61, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x67, 0x61,
+	0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4c, 0x61, 0x72, 0x67,
+	0x65, 0x47, 0x61, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x42, 0x0a,
+	0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x67,
+	0x69, 0x6d, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x22, 0x73, 0x0a, 0x0c, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0xcd, 0x02, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x72, 0x69, 0x73, 0x6b, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x6d,
+	0x65, 0x6e, 0x74, 0x75, 0x6d, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x75, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x69, 0x73, 0x6b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x72, 0x69, 0x73, 0x6b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22,
+	0xe3, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x69, 0x73, 0x6b,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x69, 0x73,
+	0x6b, 0x55, 0x6e, 0x69, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x43, 0x0a, 0x1c, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0xbe, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
 	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
 	0x72, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x2a, 0x29, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x08, 0x0a, 0x04, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x55, 0x59,
-	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x02, 0x32, 0xcf, 0x02, 0x0a,
-	0x0f, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x29, 0x2e, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x2e,
+	0x01, 0x22, 0x4f, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x58, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x36, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x43,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa2, 0x01, 0x0a,
+	0x0d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xc1, 0x03, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x12,
+	0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa0, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x48, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x72, 0x75,
+	0x6e, 0x73, 0x22, 0x2c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52,
+	0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64,
+	0x2a, 0x2f, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6d, 0x65,
+	0x12, 0x08, 0x0a, 0x04, 0x42, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x45,
+	0x41, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x55, 0x54, 0x52, 0x41, 0x4c, 0x10,
+	0x02, 0x2a, 0x40, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52,
+	0x45, 0x44, 0x55, 0x43, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x5f, 0x4e,
+	0x45, 0x57, 0x5f, 0x42, 0x55, 0x59, 0x53, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x41, 0x53,
+	0x48, 0x10, 0x03, 0x2a, 0x29, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x42,
+	0x55, 0x59, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x02, 0x32, 0xd9,
+	0x05, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x29, 0x2e,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x2d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x64, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x22, 0x00, 0x12,
+	0x69, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
 	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36,
-	0x5a, 0x34, 0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x75, 0x6d, 0x2d, 0x74, 0x72, 0x61, 0x64, 0x69,
-	0x6e, 0x67, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x6d, 0x6f,
+	0x6d, 0x65, 0x6e, 0x74, 0x75, 0x6d, 0x2d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -590,36 +1967,79 @@ func file_strategy_service_proto_rawDescGZIP() []byte {
 	return file_strategy_service_proto_rawDescData
 }
 
-var file_strategy_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_strategy_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_strategy_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_strategy_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_strategy_service_proto_goTypes = []any{
-	(SignalType)(0),                       // 0: strategyservice.SignalType
-	(*SignalRequest)(nil),                 // 1: strategyservice.SignalRequest
-	(*SignalResponse)(nil),                // 2: strategyservice.SignalResponse
-	(*StockSignal)(nil),                   // 3: strategyservice.StockSignal
-	(*ConfigureStrategyRequest)(nil),      // 4: strategyservice.ConfigureStrategyRequest
-	(*ConfigureStrategyResponse)(nil),     // 5: strategyservice.ConfigureStrategyResponse
-	(*GetStrategyParametersRequest)(nil),  // 6: strategyservice.GetStrategyParametersRequest
-	(*GetStrategyParametersResponse)(nil), // 7: strategyservice.GetStrategyParametersResponse
-	nil,                                   // 8: strategyservice.ConfigureStrategyRequest.ParametersEntry
-	nil,                                   // 9: strategyservice.GetStrategyParametersResponse.ParametersEntry
+	(MarketRegime)(0),                     // 0: strategyservice.MarketRegime
+	(RegimeAction)(0),                     // 1: strategyservice.RegimeAction
+	(SignalType)(0),                       // 2: strategyservice.SignalType
+	(*SignalRequest)(nil),                 // 3: strategyservice.SignalRequest
+	(*SignalResponse)(nil),                // 4: strategyservice.SignalResponse
+	(*SignalDiagnosticsResponse)(nil),     // 5: strategyservice.SignalDiagnosticsResponse
+	(*RegimeSignal)(nil),                  // 6: strategyservice.RegimeSignal
+	(*SignalDiagnostic)(nil),              // 7: strategyservice.SignalDiagnostic
+	(*FilterResult)(nil),                  // 8: strategyservice.FilterResult
+	(*StockSignal)(nil),                   // 9: strategyservice.StockSignal
+	(*SignalAttribution)(nil),             // 10: strategyservice.SignalAttribution
+	(*ConfigureStrategyRequest)(nil),      // 11: strategyservice.ConfigureStrategyRequest
+	(*ConfigureStrategyResponse)(nil),     // 12: strategyservice.ConfigureStrategyResponse
+	(*ValidateStrategyRequest)(nil),       // 13: strategyservice.ValidateStrategyRequest
+	(*ValidateStrategyResponse)(nil),      // 14: strategyservice.ValidateStrategyResponse
+	(*GetStrategyParametersRequest)(nil),  // 15: strategyservice.GetStrategyParametersRequest
+	(*GetStrategyParametersResponse)(nil), // 16: strategyservice.GetStrategyParametersResponse
+	(*ParameterSpec)(nil),                 // 17: strategyservice.ParameterSpec
+	(*SignalRun)(nil),                     // 18: strategyservice.SignalRun
+	(*ListSignalRunsRequest)(nil),         // 19: strategyservice.ListSignalRunsRequest
+	(*ListSignalRunsResponse)(nil),        // 20: strategyservice.ListSignalRunsResponse
+	(*GetSignalRunRequest)(nil),           // 21: strategyservice.GetSignalRunRequest
+	nil,                                   // 22: strategyservice.SignalRequest.StrategyParametersEntry
+	nil,                                   // 23: strategyservice.ConfigureStrategyRequest.ParametersEntry
+	nil,                                   // 24: strategyservice.ValidateStrategyRequest.ParametersEntry
+	nil,                                   // 25: strategyservice.GetStrategyParametersResponse.ParametersEntry
+	nil,                                   // 26: strategyservice.SignalRun.ParametersEntry
 }
 var file_strategy_service_proto_depIdxs = []int32{
-	3, // 0: strategyservice.SignalResponse.signals:type_name -> strategyservice.StockSignal
-	0, // 1: strategyservice.StockSignal.signal:type_name -> strategyservice.SignalType
-	8, // 2: strategyservice.ConfigureStrategyRequest.parameters:type_name -> strategyservice.ConfigureStrategyRequest.ParametersEntry
-	9, // 3: strategyservice.GetStrategyParametersResponse.parameters:type_name -> strategyservice.GetStrategyParametersResponse.ParametersEntry
-	1, // 4: strategyservice.StrategyService.GenerateSignals:input_type -> strategyservice.SignalRequest
-	4, // 5: strategyservice.StrategyService.ConfigureStrategy:input_type -> strategyservice.ConfigureStrategyRequest
-	6, // 6: strategyservice.StrategyService.GetStrategyParameters:input_type -> strategyservice.GetStrategyParametersRequest
-	2, // 7: strategyservice.StrategyService.GenerateSignals:output_type -> strategyservice.SignalResponse
-	5, // 8: strategyservice.StrategyService.ConfigureStrategy:output_type -> strategyservice.ConfigureStrategyResponse
-	7, // 9: strategyservice.StrategyService.GetStrategyParameters:output_type -> strategyservice.GetStrategyParametersResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	22, // 0: strategyservice.SignalRequest.strategy_parameters:type_name -> strategyservice.SignalRequest.StrategyParametersEntry
+	9,  // 1: strategyservice.SignalResponse.signals:type_name -> strategyservice.StockSignal
+	7,  // 2: strategyservice.SignalResponse.diagnostics:type_name -> strategyservice.SignalDiagnostic
+	0,  // 3: strategyservice.SignalResponse.market_regime:type_name -> strategyservice.MarketRegime
+	6,  // 4: strategyservice.SignalResponse.regime_signals:type_name -> strategyservice.RegimeSignal
+	1,  // 5: strategyservice.SignalResponse.regime_action:type_name -> strategyservice.RegimeAction
+	0,  // 6: strategyservice.SignalDiagnosticsResponse.market_regime:type_name -> strategyservice.MarketRegime
+	7,  // 7: strategyservice.SignalDiagnosticsResponse.diagnostics:type_name -> strategyservice.SignalDiagnostic
+	6,  // 8: strategyservice.SignalDiagnosticsResponse.regime_signals:type_name -> strategyservice.RegimeSignal
+	0,  // 9: strategyservice.RegimeSignal.regime:type_name -> strategyservice.MarketRegime
+	8,  // 10: strategyservice.SignalDiagnostic.filters:type_name -> strategyservice.FilterResult
+	0,  // 11: strategyservice.SignalDiagnostic.market_regime:type_name -> strategyservice.MarketRegime
+	2,  // 12: strategyservice.StockSignal.signal:type_name -> strategyservice.SignalType
+	10, // 13: strategyservice.StockSignal.attributions:type_name -> strategyservice.SignalAttribution
+	2,  // 14: strategyservice.SignalAttribution.signal:type_name -> strategyservice.SignalType
+	23, // 15: strategyservice.ConfigureStrategyRequest.parameters:type_name -> strategyservice.ConfigureStrategyRequest.ParametersEntry
+	24, // 16: strategyservice.ValidateStrategyRequest.parameters:type_name -> strategyservice.ValidateStrategyRequest.ParametersEntry
+	25, // 17: strategyservice.GetStrategyParametersResponse.parameters:type_name -> strategyservice.GetStrategyParametersResponse.ParametersEntry
+	17, // 18: strategyservice.GetStrategyParametersResponse.schema:type_name -> strategyservice.ParameterSpec
+	26, // 19: strategyservice.SignalRun.parameters:type_name -> strategyservice.SignalRun.ParametersEntry
+	4,  // 20: strategyservice.SignalRun.response:type_name -> strategyservice.SignalResponse
+	18, // 21: strategyservice.ListSignalRunsResponse.runs:type_name -> strategyservice.SignalRun
+	3,  // 22: strategyservice.StrategyService.GenerateSignals:input_type -> strategyservice.SignalRequest
+	11, // 23: strategyservice.StrategyService.ConfigureStrategy:input_type -> strategyservice.ConfigureStrategyRequest
+	15, // 24: strategyservice.StrategyService.GetStrategyParameters:input_type -> strategyservice.GetStrategyParametersRequest
+	3,  // 25: strategyservice.StrategyService.GetSignalDiagnostics:input_type -> strategyservice.SignalRequest
+	19, // 26: strategyservice.StrategyService.ListSignalRuns:input_type -> strategyservice.ListSignalRunsRequest
+	21, // 27: strategyservice.StrategyService.GetSignalRun:input_type -> strategyservice.GetSignalRunRequest
+	13, // 28: strategyservice.StrategyService.ValidateStrategy:input_type -> strategyservice.ValidateStrategyRequest
+	4,  // 29: strategyservice.StrategyService.GenerateSignals:output_type -> strategyservice.SignalResponse
+	12, // 30: strategyservice.StrategyService.ConfigureStrategy:output_type -> strategyservice.ConfigureStrategyResponse
+	16, // 31: strategyservice.StrategyService.GetStrategyParameters:output_type -> strategyservice.GetStrategyParametersResponse
+	5,  // 32: strategyservice.StrategyService.GetSignalDiagnostics:output_type -> strategyservice.SignalDiagnosticsResponse
+	20, // 33: strategyservice.StrategyService.ListSignalRuns:output_type -> strategyservice.ListSignalRunsResponse
+	18, // 34: strategyservice.StrategyService.GetSignalRun:output_type -> strategyservice.SignalRun
+	14, // 35: strategyservice.StrategyService.ValidateStrategy:output_type -> strategyservice.ValidateStrategyResponse
+	29, // [29:36] is the sub-list for method output_type
+	22, // [22:29] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_strategy_service_proto_init() }
@@ -653,7 +2073,7 @@ func file_strategy_service_proto_init() {
 			}
 		}
 		file_strategy_service_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*StockSignal); i {
+			switch v := v.(*SignalDiagnosticsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -665,7 +2085,7 @@ func file_strategy_service_proto_init() {
 			}
 		}
 		file_strategy_service_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ConfigureStrategyRequest); i {
+			switch v := v.(*RegimeSignal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -677,7 +2097,7 @@ func file_strategy_service_proto_init() {
 			}
 		}
 		file_strategy_service_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*ConfigureStrategyResponse); i {
+			switch v := v.(*SignalDiagnostic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -689,7 +2109,7 @@ func file_strategy_service_proto_init() {
 			}
 		}
 		file_strategy_service_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetStrategyParametersRequest); i {
+			switch v := v.(*FilterResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -701,6 +2121,90 @@ func file_strategy_service_proto_init() {
 			}
 		}
 		file_strategy_service_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*StockSignal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_service_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*SignalAttribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_service_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigureStrategyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_service_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigureStrategyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_service_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateStrategyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_service_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateStrategyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_service_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GetStrategyParametersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_service_proto_msgTypes[13].Exporter = func(v any, i int) any {
 			switch v := v.(*GetStrategyParametersResponse); i {
 			case 0:
 				return &v.state
@@ -712,14 +2216,75 @@ func file_strategy_service_proto_init() {
 				return nil
 			}
 		}
+		file_strategy_service_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ParameterSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_service_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*SignalRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_service_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSignalRunsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_service_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSignalRunsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_service_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSignalRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_strategy_service_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_strategy_service_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
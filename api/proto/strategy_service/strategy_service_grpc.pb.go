@@ -24,6 +24,10 @@ const (
 	StrategyService_GenerateSignals_FullMethodName       = "/strategyservice.StrategyService/GenerateSignals"
 	StrategyService_ConfigureStrategy_FullMethodName     = "/strategyservice.StrategyService/ConfigureStrategy"
 	StrategyService_GetStrategyParameters_FullMethodName = "/strategyservice.StrategyService/GetStrategyParameters"
+	StrategyService_GetSignalDiagnostics_FullMethodName  = "/strategyservice.StrategyService/GetSignalDiagnostics"
+	StrategyService_ListSignalRuns_FullMethodName        = "/strategyservice.StrategyService/ListSignalRuns"
+	StrategyService_GetSignalRun_FullMethodName          = "/strategyservice.StrategyService/GetSignalRun"
+	StrategyService_ValidateStrategy_FullMethodName      = "/strategyservice.StrategyService/ValidateStrategy"
 )
 
 // StrategyServiceClient is the client API for StrategyService service.
@@ -33,6 +37,10 @@ type StrategyServiceClient interface {
 	GenerateSignals(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*SignalResponse, error)
 	ConfigureStrategy(ctx context.Context, in *ConfigureStrategyRequest, opts ...grpc.CallOption) (*ConfigureStrategyResponse, error)
 	GetStrategyParameters(ctx context.Context, in *GetStrategyParametersRequest, opts ...grpc.CallOption) (*GetStrategyParametersResponse, error)
+	GetSignalDiagnostics(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*SignalDiagnosticsResponse, error)
+	ListSignalRuns(ctx context.Context, in *ListSignalRunsRequest, opts ...grpc.CallOption) (*ListSignalRunsResponse, error)
+	GetSignalRun(ctx context.Context, in *GetSignalRunRequest, opts ...grpc.CallOption) (*SignalRun, error)
+	ValidateStrategy(ctx context.Context, in *ValidateStrategyRequest, opts ...grpc.CallOption) (*ValidateStrategyResponse, error)
 }
 
 type strategyServiceClient struct {
@@ -73,6 +81,46 @@ func (c *strategyServiceClient) GetStrategyParameters(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *strategyServiceClient) GetSignalDiagnostics(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*SignalDiagnosticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignalDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, StrategyService_GetSignalDiagnostics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strategyServiceClient) ListSignalRuns(ctx context.Context, in *ListSignalRunsRequest, opts ...grpc.CallOption) (*ListSignalRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSignalRunsResponse)
+	err := c.cc.Invoke(ctx, StrategyService_ListSignalRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strategyServiceClient) GetSignalRun(ctx context.Context, in *GetSignalRunRequest, opts ...grpc.CallOption) (*SignalRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignalRun)
+	err := c.cc.Invoke(ctx, StrategyService_GetSignalRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strategyServiceClient) ValidateStrategy(ctx context.Context, in *ValidateStrategyRequest, opts ...grpc.CallOption) (*ValidateStrategyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateStrategyResponse)
+	err := c.cc.Invoke(ctx, StrategyService_ValidateStrategy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StrategyServiceServer is the server API for StrategyService service.
 // All implementations must embed UnimplementedStrategyServiceServer
 // for forward compatibility
@@ -80,6 +128,10 @@ type StrategyServiceServer interface {
 	GenerateSignals(context.Context, *SignalRequest) (*SignalResponse, error)
 	ConfigureStrategy(context.Context, *ConfigureStrategyRequest) (*ConfigureStrategyResponse, error)
 	GetStrategyParameters(context.Context, *GetStrategyParametersRequest) (*GetStrategyParametersResponse, error)
+	GetSignalDiagnostics(context.Context, *SignalRequest) (*SignalDiagnosticsResponse, error)
+	ListSignalRuns(context.Context, *ListSignalRunsRequest) (*ListSignalRunsResponse, error)
+	GetSignalRun(context.Context, *GetSignalRunRequest) (*SignalRun, error)
+	ValidateStrategy(context.Context, *ValidateStrategyRequest) (*ValidateStrategyResponse, error)
 	mustEmbedUnimplementedStrategyServiceServer()
 }
 
@@ -96,6 +148,18 @@ func (UnimplementedStrategyServiceServer) ConfigureStrategy(context.Context, *Co
 func (UnimplementedStrategyServiceServer) GetStrategyParameters(context.Context, *GetStrategyParametersRequest) (*GetStrategyParametersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStrategyParameters not implemented")
 }
+func (UnimplementedStrategyServiceServer) GetSignalDiagnostics(context.Context, *SignalRequest) (*SignalDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignalDiagnostics not implemented")
+}
+func (UnimplementedStrategyServiceServer) ListSignalRuns(context.Context, *ListSignalRunsRequest) (*ListSignalRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSignalRuns not implemented")
+}
+func (UnimplementedStrategyServiceServer) GetSignalRun(context.Context, *GetSignalRunRequest) (*SignalRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignalRun not implemented")
+}
+func (UnimplementedStrategyServiceServer) ValidateStrategy(context.Context, *ValidateStrategyRequest) (*ValidateStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateStrategy not implemented")
+}
 func (UnimplementedStrategyServiceServer) mustEmbedUnimplementedStrategyServiceServer() {}
 
 // UnsafeStrategyServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -163,6 +227,78 @@ func _StrategyService_GetStrategyParameters_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StrategyService_GetSignalDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyServiceServer).GetSignalDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StrategyService_GetSignalDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyServiceServer).GetSignalDiagnostics(ctx, req.(*SignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrategyService_ListSignalRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSignalRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyServiceServer).ListSignalRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StrategyService_ListSignalRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyServiceServer).ListSignalRuns(ctx, req.(*ListSignalRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrategyService_GetSignalRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignalRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyServiceServer).GetSignalRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StrategyService_GetSignalRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyServiceServer).GetSignalRun(ctx, req.(*GetSignalRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrategyService_ValidateStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyServiceServer).ValidateStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StrategyService_ValidateStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyServiceServer).ValidateStrategy(ctx, req.(*ValidateStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StrategyService_ServiceDesc is the grpc.ServiceDesc for StrategyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -182,6 +318,22 @@ var StrategyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStrategyParameters",
 			Handler:    _StrategyService_GetStrategyParameters_Handler,
 		},
+		{
+			MethodName: "GetSignalDiagnostics",
+			Handler:    _StrategyService_GetSignalDiagnostics_Handler,
+		},
+		{
+			MethodName: "ListSignalRuns",
+			Handler:    _StrategyService_ListSignalRuns_Handler,
+		},
+		{
+			MethodName: "GetSignalRun",
+			Handler:    _StrategyService_GetSignalRun_Handler,
+		},
+		{
+			MethodName: "ValidateStrategy",
+			Handler:    _StrategyService_ValidateStrategy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "strategy_service.proto",
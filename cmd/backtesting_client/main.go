@@ -30,6 +30,7 @@ func main() {
 		EndDate:        "2024-12-31",
 		InitialCapital: 100000,
 		Symbols:        []string{"AAPL", "GOOGL", "MSFT", "AMZN", "FB"},
+		StrategyName:   "momentum",
 	}
 	log.Infof("Starting backtest: %+v\n", backtestReq)
 	result, err := client.RunBacktest(ctx, backtestReq)
@@ -1,7 +1,11 @@
 package main
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
 	"net"
+	"os"
 
 	"github.com/charmbracelet/log"
 
@@ -9,6 +13,7 @@ import (
 
 	pb "momentum-trading-platform/api/proto/portfolio_service"
 
+	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -19,10 +24,27 @@ func main() {
 		log.Fatalf("Failed to create gRPC clients: %v", err)
 	}
 
-	s, err := portfolio.NewServer(clients)
+	defer clients.Close()
+
+	dbHost := os.Getenv("DB_HOST")
+	dbUser := os.Getenv("DB_USER")
+	dbPassword := os.Getenv("DB_PASSWORD")
+	dbName := os.Getenv("DB_NAME")
+	dbPort := os.Getenv("DB_PORT")
+
+	dbURI := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		dbHost, dbPort, dbUser, dbPassword, dbName)
+
+	db, err := sql.Open("postgres", dbURI)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	s, err := portfolio.NewServer(clients, db)
 	if err != nil {
-		s.Logger.WithError(err).Fatal("Failed to create server")
+		log.Fatalf("Failed to create server: %v", err)
 	}
+	s.StartScheduler(context.Background())
 
 	lis, err := net.Listen("tcp", "0.0.0.0:50054")
 	if err != nil {
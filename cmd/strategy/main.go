@@ -1,7 +1,10 @@
 package main
 
 import (
+	"database/sql"
+	"fmt"
 	"net"
+	"os"
 
 	"github.com/charmbracelet/log"
 
@@ -9,6 +12,7 @@ import (
 
 	pb "momentum-trading-platform/api/proto/strategy_service"
 
+	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -20,7 +24,33 @@ func main() {
 	}
 	defer clients.Close()
 
-	s := strategy.NewServer(clients)
+	dbHost := os.Getenv("DB_HOST")
+	dbUser := os.Getenv("DB_USER")
+	dbPassword := os.Getenv("DB_PASSWORD")
+	dbName := os.Getenv("DB_NAME")
+	dbPort := os.Getenv("DB_PORT")
+
+	dbURI := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		dbHost, dbPort, dbUser, dbPassword, dbName)
+
+	db, err := sql.Open("postgres", dbURI)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	// Declarative strategy definitions are loaded before the server registers its strategies
+	if dir := os.Getenv("STRATEGY_DIR"); dir != "" {
+		names, err := strategy.LoadStrategyDefinitions(dir)
+		if err != nil {
+			log.Fatalf("Failed to load strategy definitions: %v", err)
+		}
+		log.Infof("Loaded strategy definitions from %s: %v", dir, names)
+	}
+
+	s, err := strategy.NewServer(clients, db)
+	if err != nil {
+		log.Fatalf("Failed to create server: %v", err)
+	}
 
 	lis, err := net.Listen("tcp", "0.0.0.0:50052")
 	if err != nil {
@@ -20,7 +20,7 @@ func main() {
 
 	server := tradeexecution.NewServer(clients)
 
-	lis, err := net.Listen("tcp", ":50055")
+	lis, err := net.Listen("tcp", ":50056")
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
@@ -0,0 +1,360 @@
+package backtesting
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/backtesting_service"
+	datapb "momentum-trading-platform/api/proto/data_service"
+	strategypb "momentum-trading-platform/api/proto/strategy_service"
+	"momentum-trading-platform/internal/strategy"
+	"momentum-trading-platform/internal/utils"
+)
+
+const (
+	dateLayout         = "2006-01-02"
+	defaultWarmupDays  = 400
+	defaultMarketIndex = "^GSPC"
+	tradingDaysPerYear = 252
+)
+
+// MarketData is the price history a backtest runs on. It is loaded once and shared read-only
+// between concurrent backtests.
+type MarketData struct {
+	Stocks map[string]*datapb.StockResponse
+	Index  *datapb.StockResponse
+}
+
+// BacktestConfig describes a single simulation over loaded market data.
+type BacktestConfig struct {
+	StrategyName   string
+	Parameters     map[string]interface{}
+	Start          time.Time
+	End            time.Time
+	InitialCapital float64
+}
+
+// BacktestOutcome is the result of a simulation.
+type BacktestOutcome struct {
+	FinalValue  float64
+	TotalReturn float64
+	CAGR        float64
+	SharpeRatio float64
+	MaxDrawdown float64
+	Equity      []*pb.EquityPoint
+	Trades      []*pb.TradeRecord
+}
+
+// loadMarketData fetches the universe, the strategy's auxiliary symbols and the market index,
+// starting warmupDays before the backtest so that indicators are defined from the first day.
+func loadMarketData(ctx context.Context, client datapb.DataServiceClient, req *pb.BacktestRequest, strat strategy.Strategy) (*MarketData, error) {
+	start, err := time.Parse(dateLayout, req.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start_date %q: %v", req.StartDate, err)
+	}
+	warmupDays := int(req.WarmupDays)
+	if warmupDays <= 0 {
+		warmupDays = defaultWarmupDays
+	}
+	dataStart := start.AddDate(0, 0, -warmupDays).Format(dateLayout)
+
+	symbols := req.Symbols
+	if provider, ok := strat.(strategy.UniverseProvider); ok && len(symbols) == 0 {
+		symbols = provider.DefaultUniverse()
+	}
+	if provider, ok := strat.(strategy.SymbolProvider); ok {
+		symbols = append(append([]string(nil), symbols...), provider.RequiredSymbols()...)
+	}
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("no symbols to backtest")
+	}
+
+	batch, err := client.GetBatchStockData(ctx, &datapb.BatchStockRequest{
+		Symbols:   symbols,
+		StartDate: dataStart,
+		EndDate:   req.EndDate,
+		Interval:  "1d",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch stock data: %v", err)
+	}
+
+	marketIndex := req.MarketIndex
+	if marketIndex == "" {
+		marketIndex = defaultMarketIndex
+	}
+	index, err := client.GetStockData(ctx, &datapb.StockRequest{
+		Symbol:    marketIndex,
+		StartDate: dataStart,
+		EndDate:   req.EndDate,
+		Interval:  "1d",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch market index %s: %v", marketIndex, err)
+	}
+
+	return &MarketData{Stocks: batch.StockData, Index: index}, nil
+}
+
+// backtestConfig converts a request into a simulation config.
+func backtestConfig(req *pb.BacktestRequest) (BacktestConfig, error) {
+	start, err := time.Parse(dateLayout, req.StartDate)
+	if err != nil {
+		return BacktestConfig{}, fmt.Errorf("invalid start_date %q: %v", req.StartDate, err)
+	}
+	end, err := time.Parse(dateLayout, req.EndDate)
+	if err != nil {
+		return BacktestConfig{}, fmt.Errorf("invalid end_date %q: %v", req.EndDate, err)
+	}
+	if !end.After(start) {
+		return BacktestConfig{}, fmt.Errorf("end_date must be after start_date")
+	}
+	if req.InitialCapital <= 0 {
+		return BacktestConfig{}, fmt.Errorf("initial_capital must be positive")
+	}
+
+	strategyName := req.StrategyName
+	if strategyName == "" {
+		strategyName = "momentum"
+	}
+	params := make(map[string]interface{}, len(req.StrategyParameters))
+	for k, v := range req.StrategyParameters {
+		params[k] = v
+	}
+
+	// The end date is inclusive, so every bar on that day belongs to the backtest
+	return BacktestConfig{
+		StrategyName:   strategyName,
+		Parameters:     params,
+		Start:          start,
+		End:            end.Add(24*time.Hour - time.Second),
+		InitialCapital: req.InitialCapital,
+	}, nil
+}
+
+// runBacktest simulates the strategy day by day over the index calendar. The portfolio is
+// rebalanced weekly on the first trading day from Wednesday on, mirroring the portfolio service:
+// BUY signals are targets sized by risk units and scaled by the target exposure, HOLD signals keep
+// held positions at their quantity, and everything else is sold. Trades fill at the close, sells before buys, and
+// buys are trimmed to the available cash. Progress, when set, is called after every day.
+func runBacktest(cfg BacktestConfig, data *MarketData, progress func(float64)) (*BacktestOutcome, error) {
+	strat, err := strategy.NewStrategyFromParameters(cfg.StrategyName, cfg.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	if data.Index == nil || len(data.Index.DataPoints) == 0 {
+		return nil, fmt.Errorf("no market index data")
+	}
+
+	var calendar []int
+	for i, dp := range data.Index.DataPoints {
+		t := time.Unix(dp.Timestamp, 0).UTC()
+		if !t.Before(cfg.Start) && !t.After(cfg.End) {
+			calendar = append(calendar, i)
+		}
+	}
+	if len(calendar) == 0 {
+		return nil, fmt.Errorf("no trading days between %s and %s", cfg.Start.Format(dateLayout), cfg.End.Format(dateLayout))
+	}
+
+	cursors := newCursors(data.Stocks)
+	positions := make(map[string]int32)
+	cash := cfg.InitialCapital
+	outcome := &BacktestOutcome{}
+	var lastRebalance time.Time
+
+	for n, indexPos := range calendar {
+		day := data.Index.DataPoints[indexPos]
+		date := time.Unix(day.Timestamp, 0).UTC()
+		cursors.advance(day.Timestamp)
+
+		if isRebalanceDay(date, lastRebalance) {
+			lastRebalance = date
+			history := cursors.history()
+			index := &datapb.StockResponse{Symbol: data.Index.Symbol, DataPoints: data.Index.DataPoints[:indexPos+1]}
+			resp, err := strat.GenerateSignals(history, index)
+			if err != nil {
+				return nil, fmt.Errorf("strategy failed on %s: %v", date.Format(dateLayout), err)
+			}
+			value := cash + positionsValue(positions, cursors)
+			targets := targetQuantities(resp, positions, cursors, value)
+			trades, remaining := rebalance(positions, targets, cursors, cash, date)
+			cash = remaining
+			outcome.Trades = append(outcome.Trades, trades...)
+		}
+
+		outcome.Equity = append(outcome.Equity, &pb.EquityPoint{
+			Date:  date.Format(dateLayout),
+			Value: cash + positionsValue(positions, cursors),
+		})
+		if progress != nil {
+			progress(float64(n+1) / float64(len(calendar)))
+		}
+	}
+
+	outcome.FinalValue = outcome.Equity[len(outcome.Equity)-1].Value
+	outcome.TotalReturn = outcome.FinalValue/cfg.InitialCapital - 1
+	outcome.SharpeRatio, outcome.CAGR, outcome.MaxDrawdown = equityMetrics(outcome.Equity, cfg.InitialCapital)
+	return outcome, nil
+}
+
+// isRebalanceDay reports whether date is the first trading day from Wednesday on in a week
+// without a rebalance yet.
+func isRebalanceDay(date, lastRebalance time.Time) bool {
+	if date.Weekday() < time.Wednesday {
+		return false
+	}
+	year, week := date.ISOWeek()
+	lastYear, lastWeek := lastRebalance.ISOWeek()
+	return lastRebalance.IsZero() || year != lastYear || week != lastWeek
+}
+
+// cursors tracks, for each symbol, how many of its data points are on or before the current day,
+// so that strategies only ever see history available at the time.
+type cursors struct {
+	stocks    map[string]*datapb.StockResponse
+	positions map[string]int
+}
+
+func newCursors(stocks map[string]*datapb.StockResponse) *cursors {
+	return &cursors{stocks: stocks, positions: make(map[string]int, len(stocks))}
+}
+
+func (c *cursors) advance(timestamp int64) {
+	for symbol, stock := range c.stocks {
+		pos := c.positions[symbol]
+		for pos < len(stock.DataPoints) && stock.DataPoints[pos].Timestamp <= timestamp {
+			pos++
+		}
+		c.positions[symbol] = pos
+	}
+}
+
+// history returns every symbol's data up to the current day. The slices share the loaded data.
+func (c *cursors) history() map[string]*datapb.StockResponse {
+	history := make(map[string]*datapb.StockResponse, len(c.stocks))
+	for symbol, stock := range c.stocks {
+		history[symbol] = &datapb.StockResponse{Symbol: symbol, DataPoints: stock.DataPoints[:c.positions[symbol]]}
+	}
+	return history
+}
+
+// price returns the latest close of the symbol, or zero before its first data point.
+func (c *cursors) price(symbol string) float64 {
+	pos := c.positions[symbol]
+	if pos == 0 {
+		return 0
+	}
+	return c.stocks[symbol].DataPoints[pos-1].Close
+}
+
+func positionsValue(positions map[string]int32, c *cursors) float64 {
+	value := 0.0
+	for symbol, quantity := range positions {
+		value += float64(quantity) * c.price(symbol)
+	}
+	return value
+}
+
+// targetQuantities keeps held positions with a HOLD signal at their quantity and allocates the rest
+// of the target exposure of the portfolio value across BUY signals in proportion to their risk
+// units, as the portfolio service does.
+func targetQuantities(resp *strategypb.SignalResponse, positions map[string]int32, c *cursors, value float64) map[string]int32 {
+	exposure := utils.TargetExposure(resp)
+
+	quantities := make(map[string]int32)
+	available := value * exposure
+	var targets []*strategypb.StockSignal
+	totalRiskUnits := 0.0
+	for _, signal := range resp.Signals {
+		if _, kept := quantities[signal.Symbol]; kept {
+			continue
+		}
+		held, isHeld := positions[signal.Symbol]
+		switch {
+		case signal.Signal == strategypb.SignalType_HOLD && isHeld:
+			quantities[signal.Symbol] = held
+			available -= float64(held) * c.price(signal.Symbol)
+		case signal.Signal == strategypb.SignalType_BUY && validRiskUnit(signal.RiskUnit) && c.price(signal.Symbol) > 0:
+			targets = append(targets, signal)
+			totalRiskUnits += signal.RiskUnit
+		}
+	}
+	if available <= 0 {
+		return quantities
+	}
+
+	for _, signal := range targets {
+		allocation := signal.RiskUnit / totalRiskUnits * available
+		if quantity := int32(allocation / c.price(signal.Symbol)); quantity > 0 {
+			quantities[signal.Symbol] = quantity
+		}
+	}
+	return quantities
+}
+
+func validRiskUnit(riskUnit float64) bool {
+	return riskUnit > 0 && !math.IsInf(riskUnit, 0) && !math.IsNaN(riskUnit)
+}
+
+// rebalance trades the positions to the targets at the current close and returns the trades and
+// the remaining cash. Symbols are traded in alphabetical order so that results are reproducible.
+func rebalance(positions, targets map[string]int32, c *cursors, cash float64, date time.Time) ([]*pb.TradeRecord, float64) {
+	var trades []*pb.TradeRecord
+	symbols := make([]string, 0, len(positions)+len(targets))
+	for symbol := range positions {
+		symbols = append(symbols, symbol)
+	}
+	for symbol := range targets {
+		if _, ok := positions[symbol]; !ok {
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.Strings(symbols)
+
+	record := func(symbol, action string, quantity int32, price float64) {
+		trades = append(trades, &pb.TradeRecord{
+			Date:     date.Format(dateLayout),
+			Symbol:   symbol,
+			Action:   action,
+			Quantity: quantity,
+			Price:    price,
+		})
+	}
+
+	// Sells first so that their proceeds fund the buys
+	for _, symbol := range symbols {
+		price := c.price(symbol)
+		if delta := targets[symbol] - positions[symbol]; delta < 0 && price > 0 {
+			cash += float64(-delta) * price
+			setPosition(positions, symbol, targets[symbol])
+			record(symbol, "SELL", -delta, price)
+		}
+	}
+	for _, symbol := range symbols {
+		price := c.price(symbol)
+		delta := targets[symbol] - positions[symbol]
+		if delta <= 0 || price <= 0 {
+			continue
+		}
+		delta = min(delta, int32(cash/price))
+		if delta <= 0 {
+			continue
+		}
+		cash -= float64(delta) * price
+		setPosition(positions, symbol, positions[symbol]+delta)
+		record(symbol, "BUY", delta, price)
+	}
+	return trades, cash
+}
+
+func setPosition(positions map[string]int32, symbol string, quantity int32) {
+	if quantity == 0 {
+		delete(positions, symbol)
+		return
+	}
+	positions[symbol] = quantity
+}
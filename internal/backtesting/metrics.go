@@ -0,0 +1,48 @@
+package backtesting
+
+import (
+	"math"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/backtesting_service"
+
+	"gonum.org/v1/gonum/stat"
+)
+
+// equityMetrics returns the annualized Sharpe ratio of daily returns (with a zero risk-free rate),
+// the compound annual growth rate and the maximum drawdown as a positive fraction.
+func equityMetrics(equity []*pb.EquityPoint, initialCapital float64) (sharpe, cagr, maxDrawdown float64) {
+	if len(equity) == 0 || initialCapital <= 0 {
+		return 0, 0, 0
+	}
+
+	returns := make([]float64, 0, len(equity))
+	previous := initialCapital
+	peak := initialCapital
+	for _, point := range equity {
+		if previous > 0 {
+			returns = append(returns, point.Value/previous-1)
+		}
+		previous = point.Value
+		peak = math.Max(peak, point.Value)
+		if peak > 0 {
+			maxDrawdown = math.Max(maxDrawdown, 1-point.Value/peak)
+		}
+	}
+
+	if len(returns) > 1 {
+		mean, std := stat.MeanStdDev(returns, nil)
+		if std > 0 {
+			sharpe = mean / std * math.Sqrt(tradingDaysPerYear)
+		}
+	}
+
+	first, _ := time.Parse(dateLayout, equity[0].Date)
+	last, _ := time.Parse(dateLayout, equity[len(equity)-1].Date)
+	years := last.Sub(first).Hours() / 24 / 365.25
+	final := equity[len(equity)-1].Value
+	if years > 0 && final > 0 {
+		cagr = math.Pow(final/initialCapital, 1/years) - 1
+	}
+	return sharpe, cagr, maxDrawdown
+}
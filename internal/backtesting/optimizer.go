@@ -0,0 +1,606 @@
+package backtesting
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/backtesting_service"
+	"momentum-trading-platform/internal/strategy"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	searchGrid     = "grid"
+	searchRandom   = "random"
+	searchBayesian = "bayesian"
+
+	objectiveSharpe    = "sharpe"
+	objectiveCAGRMaxDD = "cagr_maxdd"
+
+	defaultMaxEvaluations = 50
+	maxGridEvaluations    = 1000
+
+	// minDrawdown floors the drawdown in cagr_maxdd so that a nearly flat equity curve does not
+	// produce an unbounded objective.
+	minDrawdown = 0.01
+)
+
+// dimension is one parameter of the search space. Categorical dimensions list their values;
+// numeric dimensions span [min, max] and are gridded by step.
+type dimension struct {
+	name     string
+	integer  bool
+	min, max float64
+	step     float64
+	snap     bool // Sampled values are rounded to the step, set when the step was given explicitly
+	values   []string
+}
+
+// newSearchSpace builds the dimensions for the requested ranges, taking the type and default
+// bounds of each parameter from the strategy's parameter schema.
+func newSearchSpace(strategyName string, ranges []*pb.ParameterRange) ([]dimension, error) {
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("at least one parameter range is required")
+	}
+	strat, err := strategy.NewStrategy(strategyName)
+	if err != nil {
+		return nil, err
+	}
+	specs := make(map[string]strategy.ParameterSpec)
+	for _, spec := range strat.ParameterSchema() {
+		specs[spec.Name] = spec
+	}
+
+	space := make([]dimension, 0, len(ranges))
+	seen := make(map[string]bool)
+	for _, r := range ranges {
+		spec, ok := specs[r.Name]
+		if !ok {
+			return nil, fmt.Errorf("strategy %s has no parameter %q", strategyName, r.Name)
+		}
+		if seen[r.Name] {
+			return nil, fmt.Errorf("parameter %q is listed twice", r.Name)
+		}
+		seen[r.Name] = true
+
+		d := dimension{name: r.Name, integer: spec.Type == strategy.IntParameter}
+		if len(r.Values) > 0 {
+			d.values = r.Values
+			space = append(space, d)
+			continue
+		}
+		if spec.Type != strategy.IntParameter && spec.Type != strategy.FloatParameter {
+			return nil, fmt.Errorf("parameter %q is a %s and needs explicit values", r.Name, spec.Type)
+		}
+
+		d.min, d.max = r.Min, r.Max
+		if d.min == 0 && d.max == 0 {
+			d.min, d.max = spec.Min, spec.Max
+		}
+		if d.max <= d.min {
+			return nil, fmt.Errorf("parameter %q needs a range with max above min", r.Name)
+		}
+		d.step, d.snap = r.Step, r.Step > 0
+		if d.step <= 0 {
+			d.step = (d.max - d.min) / 4
+		}
+		if d.integer {
+			d.step = math.Max(1, math.Round(d.step))
+		}
+		space = append(space, d)
+	}
+	return space, nil
+}
+
+func (d dimension) format(value float64) string {
+	if d.integer {
+		return strconv.Itoa(int(math.Round(value)))
+	}
+	return strconv.FormatFloat(math.Round(value*1e6)/1e6, 'f', -1, 64)
+}
+
+// gridValues returns the distinct values of the dimension on its grid.
+func (d dimension) gridValues() []string {
+	if len(d.values) > 0 {
+		return d.values
+	}
+	var values []string
+	seen := make(map[string]bool)
+	for i := 0; ; i++ {
+		v := d.min + float64(i)*d.step
+		if v > d.max+d.step*1e-9 {
+			break
+		}
+		if formatted := d.format(math.Min(v, d.max)); !seen[formatted] {
+			seen[formatted] = true
+			values = append(values, formatted)
+		}
+	}
+	return values
+}
+
+func (d dimension) sample(rng *rand.Rand) string {
+	if len(d.values) > 0 {
+		return d.values[rng.Intn(len(d.values))]
+	}
+	v := d.min + rng.Float64()*(d.max-d.min)
+	if d.snap {
+		v = math.Min(d.min+math.Round((v-d.min)/d.step)*d.step, d.max)
+	}
+	return d.format(v)
+}
+
+// coordinate maps a value onto [0, 1] for the surrogate model.
+func (d dimension) coordinate(value string) float64 {
+	if len(d.values) > 0 {
+		if len(d.values) == 1 {
+			return 0
+		}
+		for i, v := range d.values {
+			if v == value {
+				return float64(i) / float64(len(d.values)-1)
+			}
+		}
+		return 0
+	}
+	v, _ := strconv.ParseFloat(value, 64)
+	return (v - d.min) / (d.max - d.min)
+}
+
+// gridSize returns the number of points in the full grid.
+func gridSize(space []dimension) int {
+	size := 1
+	for _, d := range space {
+		size *= len(d.gridValues())
+		if size > maxGridEvaluations {
+			return size
+		}
+	}
+	return size
+}
+
+// optimizer searches the parameter space by running backtests in parallel on shared market data.
+type optimizer struct {
+	base           BacktestConfig
+	data           *MarketData
+	space          []dimension
+	method         string
+	objective      string
+	maxEvaluations int
+	parallelism    int
+	rng            *rand.Rand
+	onTrial        func(*pb.OptimizationTrial)
+	trials         []*pb.OptimizationTrial
+	seen           map[string]bool
+}
+
+func (o *optimizer) run() []*pb.OptimizationTrial {
+	o.seen = make(map[string]bool)
+	switch o.method {
+	case searchGrid:
+		o.evaluate(o.grid())
+	case searchRandom:
+		o.evaluate(o.randomCandidates(o.maxEvaluations))
+	default:
+		o.runBayesian()
+	}
+	return o.trials
+}
+
+// grid returns the cartesian product of every dimension's grid values.
+func (o *optimizer) grid() []map[string]string {
+	candidates := []map[string]string{{}}
+	for _, d := range o.space {
+		var next []map[string]string
+		for _, candidate := range candidates {
+			for _, value := range d.gridValues() {
+				extended := make(map[string]string, len(candidate)+1)
+				for k, v := range candidate {
+					extended[k] = v
+				}
+				extended[d.name] = value
+				next = append(next, extended)
+			}
+		}
+		candidates = next
+	}
+	return candidates
+}
+
+// randomCandidates draws up to count parameter sets that have not been evaluated yet. Fewer are
+// returned when the space is nearly exhausted.
+func (o *optimizer) randomCandidates(count int) []map[string]string {
+	var candidates []map[string]string
+	for attempts := 0; len(candidates) < count && attempts < count*20; attempts++ {
+		candidate := make(map[string]string, len(o.space))
+		for _, d := range o.space {
+			candidate[d.name] = d.sample(o.rng)
+		}
+		if key := parameterKey(candidate); !o.seen[key] {
+			o.seen[key] = true
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates
+}
+
+// runBayesian starts with random trials and then proposes batches that maximize an upper
+// confidence bound of a kernel regression surrogate fitted to the objectives seen so far. Each
+// batch holds one trial per worker so that the search stays parallel.
+func (o *optimizer) runBayesian() {
+	initial := min(max(o.maxEvaluations/4, o.parallelism, 2), o.maxEvaluations)
+	o.evaluate(o.randomCandidates(initial))
+	for len(o.trials) < o.maxEvaluations {
+		batch := o.proposeBatch(min(o.parallelism, o.maxEvaluations-len(o.trials)))
+		if len(batch) == 0 {
+			return
+		}
+		o.evaluate(batch)
+	}
+}
+
+type observation struct {
+	point []float64
+	value float64
+}
+
+func (o *optimizer) proposeBatch(count int) []map[string]string {
+	// Objectives are standardized so that the exploration bonus has a fixed scale
+	var observations []observation
+	var values []float64
+	for _, trial := range o.trials {
+		if trial.Error == "" {
+			values = append(values, trial.Objective)
+		}
+	}
+	mean, std := meanStd(values)
+	if std == 0 {
+		std = 1
+	}
+	for _, trial := range o.trials {
+		if trial.Error == "" {
+			observations = append(observations, observation{o.point(trial.Parameters), (trial.Objective - mean) / std})
+		}
+	}
+
+	pool := o.randomCandidates(count * 50)
+	var batch []map[string]string
+	for len(batch) < count && len(pool) > 0 {
+		best, bestScore := -1, math.Inf(-1)
+		for i, candidate := range pool {
+			predicted, uncertainty := predict(observations, o.point(candidate))
+			if score := predicted + 2*uncertainty; score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		chosen := pool[best]
+		batch = append(batch, chosen)
+		pool = append(pool[:best], pool[best+1:]...)
+
+		// Pretend the chosen point scored its prediction so that the rest of the batch explores elsewhere
+		predicted, _ := predict(observations, o.point(chosen))
+		observations = append(observations, observation{o.point(chosen), predicted})
+	}
+	// Candidates that were drawn but not chosen may be proposed again later
+	for _, candidate := range pool {
+		delete(o.seen, parameterKey(candidate))
+	}
+	return batch
+}
+
+func (o *optimizer) point(params map[string]string) []float64 {
+	point := make([]float64, len(o.space))
+	for i, d := range o.space {
+		point[i] = d.coordinate(params[d.name])
+	}
+	return point
+}
+
+// predict returns the Nadaraya-Watson estimate at x with a Gaussian kernel and an uncertainty
+// that shrinks with the kernel weight of nearby observations.
+func predict(observations []observation, x []float64) (float64, float64) {
+	const bandwidth = 0.2
+	weightSum, weighted := 0.0, 0.0
+	for _, obs := range observations {
+		distance := 0.0
+		for i := range x {
+			distance += (x[i] - obs.point[i]) * (x[i] - obs.point[i])
+		}
+		w := math.Exp(-distance / (2 * bandwidth * bandwidth))
+		weightSum += w
+		weighted += w * obs.value
+	}
+	if weightSum < 1e-12 {
+		return 0, 1
+	}
+	return weighted / weightSum, 1 / math.Sqrt(1+weightSum)
+}
+
+// evaluate runs the candidates on a pool of parallelism workers and appends the trials in
+// candidate order.
+func (o *optimizer) evaluate(candidates []map[string]string) {
+	trials := make([]*pb.OptimizationTrial, len(candidates))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(o.parallelism, len(candidates)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				trials[i] = o.evaluateOne(len(o.trials)+i+1, candidates[i])
+				if o.onTrial != nil {
+					o.onTrial(trials[i])
+				}
+			}
+		}()
+	}
+	for i := range candidates {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	o.trials = append(o.trials, trials...)
+}
+
+func (o *optimizer) evaluateOne(number int, params map[string]string) *pb.OptimizationTrial {
+	trial := &pb.OptimizationTrial{Trial: int32(number), Parameters: params}
+	outcome, err := runBacktest(o.config(params), o.data, nil)
+	if err != nil {
+		trial.Error = err.Error()
+		return trial
+	}
+	trial.SharpeRatio = outcome.SharpeRatio
+	trial.Cagr = outcome.CAGR
+	trial.MaxDrawdown = outcome.MaxDrawdown
+	trial.TotalReturn = outcome.TotalReturn
+	trial.Objective = objectiveValue(o.objective, outcome)
+	return trial
+}
+
+// config returns the base backtest with the searched parameters applied over the fixed ones.
+func (o *optimizer) config(params map[string]string) BacktestConfig {
+	cfg := o.base
+	cfg.Parameters = make(map[string]interface{}, len(o.base.Parameters)+len(params))
+	for k, v := range o.base.Parameters {
+		cfg.Parameters[k] = v
+	}
+	for k, v := range params {
+		cfg.Parameters[k] = v
+	}
+	return cfg
+}
+
+func objectiveValue(objective string, outcome *BacktestOutcome) float64 {
+	if objective == objectiveCAGRMaxDD {
+		return outcome.CAGR / math.Max(outcome.MaxDrawdown, minDrawdown)
+	}
+	return outcome.SharpeRatio
+}
+
+// parameterKey identifies a parameter set independently of map order.
+func parameterKey(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%s=%s;", k, params[k])
+	}
+	return b.String()
+}
+
+func meanStd(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	mean := 0.0
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+	variance := 0.0
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(values)))
+}
+
+// sortTrials orders trials by objective, best first, with failed trials last.
+func sortTrials(trials []*pb.OptimizationTrial) {
+	sort.SliceStable(trials, func(i, j int) bool {
+		if (trials[i].Error == "") != (trials[j].Error == "") {
+			return trials[i].Error == ""
+		}
+		return trials[i].Objective > trials[j].Objective
+	})
+}
+
+// newOptimizer validates an optimization request and returns the optimizer for it, the strategy
+// whose data requirements decide what to load and the number of trials the search will run.
+func newOptimizer(req *pb.OptimizationRequest) (*optimizer, strategy.Strategy, int, error) {
+	if req.Backtest == nil {
+		return nil, nil, 0, fmt.Errorf("backtest settings are required")
+	}
+	cfg, err := backtestConfig(req.Backtest)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	strat, err := strategy.NewStrategyFromParameters(cfg.StrategyName, cfg.Parameters)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("invalid strategy %s: %v", cfg.StrategyName, err)
+	}
+	space, err := newSearchSpace(cfg.StrategyName, req.Parameters)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	method := req.Method
+	if method == "" {
+		method = searchGrid
+	}
+	objective := req.Objective
+	if objective == "" {
+		objective = objectiveSharpe
+	}
+	if method != searchGrid && method != searchRandom && method != searchBayesian {
+		return nil, nil, 0, fmt.Errorf("method must be %q, %q or %q", searchGrid, searchRandom, searchBayesian)
+	}
+	if objective != objectiveSharpe && objective != objectiveCAGRMaxDD {
+		return nil, nil, 0, fmt.Errorf("objective must be %q or %q", objectiveSharpe, objectiveCAGRMaxDD)
+	}
+
+	maxEvaluations := int(req.MaxEvaluations)
+	total := maxEvaluations
+	if method == searchGrid {
+		total = gridSize(space)
+		if total > maxGridEvaluations || (maxEvaluations > 0 && total > maxEvaluations) {
+			return nil, nil, 0, fmt.Errorf("grid has %d points, more than allowed; narrow the ranges or use random search", total)
+		}
+	} else if maxEvaluations <= 0 {
+		maxEvaluations, total = defaultMaxEvaluations, defaultMaxEvaluations
+	}
+	parallelism := int(req.Parallelism)
+	if parallelism <= 0 {
+		parallelism = runtime.NumCPU()
+	}
+	seed := req.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	return &optimizer{
+		base:           cfg,
+		space:          space,
+		method:         method,
+		objective:      objective,
+		maxEvaluations: maxEvaluations,
+		parallelism:    parallelism,
+		rng:            rand.New(rand.NewSource(seed)),
+	}, strat, total, nil
+}
+
+// forPeriod returns a fresh optimizer with the same settings that backtests only start to end.
+func (o *optimizer) forPeriod(start, end time.Time) *optimizer {
+	period := *o
+	period.base.Start, period.base.End = start, end
+	period.trials, period.seen = nil, nil
+	return &period
+}
+
+// RunOptimization validates the search and runs it in the background. Market data is loaded
+// once and shared by every trial. Progress and the trials finished so far are available from
+// GetOptimizationResult while the search runs.
+func (s *Server) RunOptimization(ctx context.Context, req *pb.OptimizationRequest) (*pb.OptimizationStatus, error) {
+	o, strat, total, err := newOptimizer(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	optimizationID := uuid.New().String()
+	result := &pb.OptimizationResult{
+		OptimizationId: optimizationID,
+		Status:         &pb.OptimizationStatus{OptimizationId: optimizationID, Status: statusRunning, TotalTrials: int32(total)},
+		StrategyName:   o.base.StrategyName,
+		Method:         o.method,
+		Objective:      o.objective,
+	}
+	s.mu.Lock()
+	s.evictFinishedJobs()
+	s.optimizations[optimizationID] = result
+	s.mu.Unlock()
+
+	s.Logger.WithFields(log.Fields{
+		"optimizationId": optimizationID,
+		"strategy":       o.base.StrategyName,
+		"method":         o.method,
+		"objective":      o.objective,
+		"trials":         total,
+		"parallelism":    o.parallelism,
+	}).Info("🚀 Starting optimization")
+
+	go s.runOptimizationJob(optimizationID, req.Backtest, strat, o)
+
+	return proto.Clone(result.Status).(*pb.OptimizationStatus), nil
+}
+
+func (s *Server) GetOptimizationResult(ctx context.Context, req *pb.OptimizationResultRequest) (*pb.OptimizationResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, exists := s.optimizations[req.OptimizationId]
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "optimization not found")
+	}
+	return proto.Clone(result).(*pb.OptimizationResult), nil
+}
+
+func (s *Server) runOptimizationJob(optimizationID string, req *pb.BacktestRequest, strat strategy.Strategy, o *optimizer) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	data, err := loadMarketData(ctx, s.Clients.DataClient, req, strat)
+	if err != nil {
+		s.failOptimization(optimizationID, err)
+		return
+	}
+	o.data = data
+	o.onTrial = func(trial *pb.OptimizationTrial) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		result := s.optimizations[optimizationID]
+		result.Trials = append(result.Trials, trial)
+		result.Status.CompletedTrials = int32(len(result.Trials))
+		if result.Status.TotalTrials > 0 {
+			result.Status.Progress = float64(result.Status.CompletedTrials) / float64(result.Status.TotalTrials) * 100
+		}
+	}
+
+	trials := o.run()
+	sortTrials(trials)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := s.optimizations[optimizationID]
+	result.Trials = trials
+	result.Status.Status = statusCompleted
+	result.Status.CompletedTrials = int32(len(trials))
+	result.Status.TotalTrials = int32(len(trials))
+	result.Status.Progress = 100
+	s.finishJob(optimizationID)
+	if len(trials) > 0 && trials[0].Error == "" {
+		result.BestParameters = trials[0].Parameters
+		result.BestObjective = trials[0].Objective
+	}
+	s.Logger.WithFields(log.Fields{
+		"optimizationId": optimizationID,
+		"trials":         len(trials),
+		"bestObjective":  result.BestObjective,
+		"bestParameters": result.BestParameters,
+	}).Info("✅ Optimization completed")
+}
+
+func (s *Server) failOptimization(optimizationID string, err error) {
+	s.Logger.WithError(err).WithField("optimizationId", optimizationID).Error("❌ Optimization failed")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := s.optimizations[optimizationID]
+	result.Status.Status = statusFailed
+	result.Status.Error = err.Error()
+	s.finishJob(optimizationID)
+}
@@ -0,0 +1,195 @@
+package backtesting
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/backtesting_service"
+	"momentum-trading-platform/internal/strategy"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	statusRunning   = "RUNNING"
+	statusCompleted = "COMPLETED"
+	statusFailed    = "FAILED"
+)
+
+// jobRetention is how long a finished optimization or walk-forward analysis is kept in memory
+// for GetOptimizationResult and GetWalkForwardResult.
+const jobRetention = 24 * time.Hour
+
+type Server struct {
+	pb.UnimplementedBacktestingServiceServer
+	Logger        *log.Logger
+	Clients       *Clients
+	backtests     map[string]*pb.BacktestResult
+	optimizations map[string]*pb.OptimizationResult
+	walkForwards  map[string]*pb.WalkForwardResult
+	finishedAt    map[string]time.Time // When each finished optimization or walk-forward analysis ended
+	mu            sync.Mutex
+}
+
+func NewServer(clients *Clients) *Server {
+	logger := log.New()
+	logger.SetLevel(log.TraceLevel)
+	logger.SetFormatter(&log.TextFormatter{
+		FullTimestamp: true,
+	})
+
+	return &Server{
+		Logger:        logger,
+		Clients:       clients,
+		backtests:     make(map[string]*pb.BacktestResult),
+		optimizations: make(map[string]*pb.OptimizationResult),
+		walkForwards:  make(map[string]*pb.WalkForwardResult),
+		finishedAt:    make(map[string]time.Time),
+	}
+}
+
+// finishJob records that an optimization or walk-forward analysis ended. Callers hold mu.
+func (s *Server) finishJob(id string) {
+	s.finishedAt[id] = time.Now()
+}
+
+// evictFinishedJobs drops the optimizations and walk-forward analyses that ended more than
+// jobRetention ago, so that results do not accumulate for the lifetime of the service. Callers
+// hold mu.
+func (s *Server) evictFinishedJobs() {
+	for id, finished := range s.finishedAt {
+		if time.Since(finished) < jobRetention {
+			continue
+		}
+		delete(s.optimizations, id)
+		delete(s.walkForwards, id)
+		delete(s.finishedAt, id)
+	}
+}
+
+// RunBacktest validates the request and starts the simulation in the background. The result is
+// retrieved with GetBacktestResult once GetBacktestStatus reports it completed.
+func (s *Server) RunBacktest(ctx context.Context, req *pb.BacktestRequest) (*pb.BacktestResult, error) {
+	cfg, err := backtestConfig(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	strat, err := strategy.NewStrategyFromParameters(cfg.StrategyName, cfg.Parameters)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid strategy %s: %v", cfg.StrategyName, err)
+	}
+
+	backtestID := uuid.New().String()
+	result := &pb.BacktestResult{
+		BacktestId:   backtestID,
+		StrategyName: cfg.StrategyName,
+		Status:       &pb.BacktestStatus{BacktestId: backtestID, Status: statusRunning},
+	}
+	s.mu.Lock()
+	s.backtests[backtestID] = result
+	s.mu.Unlock()
+
+	s.Logger.WithFields(log.Fields{
+		"backtestId": backtestID,
+		"strategy":   cfg.StrategyName,
+		"start":      req.StartDate,
+		"end":        req.EndDate,
+	}).Info("🚀 Starting backtest")
+
+	go s.runBacktestJob(backtestID, req, cfg, strat)
+
+	return &pb.BacktestResult{
+		BacktestId:   backtestID,
+		StrategyName: cfg.StrategyName,
+		Status:       &pb.BacktestStatus{BacktestId: backtestID, Status: statusRunning},
+	}, nil
+}
+
+func (s *Server) GetBacktestStatus(ctx context.Context, req *pb.BacktestStatusRequest) (*pb.BacktestStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, exists := s.backtests[req.BacktestId]
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "backtest not found")
+	}
+	return &pb.BacktestStatus{
+		BacktestId: req.BacktestId,
+		Status:     result.Status.Status,
+		Progress:   result.Status.Progress,
+		Error:      result.Status.Error,
+	}, nil
+}
+
+func (s *Server) GetBacktestResult(ctx context.Context, req *pb.BacktestStatusRequest) (*pb.BacktestResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, exists := s.backtests[req.BacktestId]
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "backtest not found")
+	}
+	if result.Status.Status == statusRunning {
+		return nil, status.Errorf(codes.FailedPrecondition, "backtest %s is still running", req.BacktestId)
+	}
+	return result, nil
+}
+
+func (s *Server) runBacktestJob(backtestID string, req *pb.BacktestRequest, cfg BacktestConfig, strat strategy.Strategy) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	data, err := loadMarketData(ctx, s.Clients.DataClient, req, strat)
+	if err != nil {
+		s.failBacktest(backtestID, err)
+		return
+	}
+
+	outcome, err := runBacktest(cfg, data, func(progress float64) {
+		s.mu.Lock()
+		s.backtests[backtestID].Status.Progress = progress * 100
+		s.mu.Unlock()
+	})
+	if err != nil {
+		s.failBacktest(backtestID, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.backtests[backtestID] = &pb.BacktestResult{
+		BacktestId:          backtestID,
+		StrategyName:        cfg.StrategyName,
+		Status:              &pb.BacktestStatus{BacktestId: backtestID, Status: statusCompleted, Progress: 100},
+		FinalPortfolioValue: outcome.FinalValue,
+		TotalReturn:         outcome.TotalReturn,
+		Cagr:                outcome.CAGR,
+		SharpeRatio:         outcome.SharpeRatio,
+		MaxDrawdown:         outcome.MaxDrawdown,
+		Trades:              outcome.Trades,
+		EquityCurve:         outcome.Equity,
+	}
+	s.Logger.WithFields(log.Fields{
+		"backtestId":  backtestID,
+		"totalReturn": outcome.TotalReturn,
+		"sharpe":      outcome.SharpeRatio,
+		"maxDrawdown": outcome.MaxDrawdown,
+		"trades":      len(outcome.Trades),
+	}).Info("✅ Backtest completed")
+}
+
+func (s *Server) failBacktest(backtestID string, err error) {
+	s.Logger.WithError(err).WithField("backtestId", backtestID).Error("❌ Backtest failed")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.backtests[backtestID].Status = &pb.BacktestStatus{
+		BacktestId: backtestID,
+		Status:     statusFailed,
+		Error:      err.Error(),
+	}
+}
@@ -0,0 +1,283 @@
+package backtesting
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/backtesting_service"
+	"momentum-trading-platform/internal/strategy"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultInSampleDays    = 730
+	defaultOutOfSampleDays = 182
+)
+
+// walkForwardWindow is one in-sample period followed by its out-of-sample period. Ends are
+// exclusive.
+type walkForwardWindow struct {
+	inSampleStart    time.Time
+	inSampleEnd      time.Time
+	outOfSampleStart time.Time
+	outOfSampleEnd   time.Time
+}
+
+// walkForwardWindows splits [start, end) into contiguous out-of-sample periods, each preceded by
+// inSampleDays of in-sample data. Anchored windows keep every in-sample period starting at start.
+func walkForwardWindows(start, end time.Time, inSampleDays, outOfSampleDays int, anchored bool) []walkForwardWindow {
+	var windows []walkForwardWindow
+	for oosStart := start.AddDate(0, 0, inSampleDays); oosStart.Before(end); {
+		oosEnd := oosStart.AddDate(0, 0, outOfSampleDays)
+		if oosEnd.After(end) {
+			oosEnd = end
+		}
+		isStart := oosStart.AddDate(0, 0, -inSampleDays)
+		if anchored {
+			isStart = start
+		}
+		windows = append(windows, walkForwardWindow{
+			inSampleStart:    isStart,
+			inSampleEnd:      oosStart,
+			outOfSampleStart: oosStart,
+			outOfSampleEnd:   oosEnd,
+		})
+		oosStart = oosEnd
+	}
+	return windows
+}
+
+// lastDay formats the final day of a period with an exclusive end.
+func lastDay(end time.Time) string {
+	return end.Add(-time.Second).Format(dateLayout)
+}
+
+// RunWalkForward validates the analysis and runs it in the background. Each window optimizes on
+// its in-sample period and backtests the best parameters on the out-of-sample period, starting
+// with the capital the previous window ended with.
+func (s *Server) RunWalkForward(ctx context.Context, req *pb.WalkForwardRequest) (*pb.WalkForwardStatus, error) {
+	if req.Optimization == nil {
+		return nil, status.Error(codes.InvalidArgument, "optimization settings are required")
+	}
+	o, strat, _, err := newOptimizer(req.Optimization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	inSampleDays := int(req.InSampleDays)
+	if inSampleDays <= 0 {
+		inSampleDays = defaultInSampleDays
+	}
+	outOfSampleDays := int(req.OutOfSampleDays)
+	if outOfSampleDays <= 0 {
+		outOfSampleDays = defaultOutOfSampleDays
+	}
+	windows := walkForwardWindows(o.base.Start, o.base.End.Add(time.Second), inSampleDays, outOfSampleDays, req.Anchored)
+	if len(windows) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "the backtest period is shorter than the %d in-sample days", inSampleDays)
+	}
+
+	walkForwardID := uuid.New().String()
+	result := &pb.WalkForwardResult{
+		WalkForwardId: walkForwardID,
+		Status:        &pb.WalkForwardStatus{WalkForwardId: walkForwardID, Status: statusRunning, TotalWindows: int32(len(windows))},
+		StrategyName:  o.base.StrategyName,
+		Objective:     o.objective,
+	}
+	s.mu.Lock()
+	s.evictFinishedJobs()
+	s.walkForwards[walkForwardID] = result
+	s.mu.Unlock()
+
+	s.Logger.WithFields(log.Fields{
+		"walkForwardId":   walkForwardID,
+		"strategy":        o.base.StrategyName,
+		"windows":         len(windows),
+		"inSampleDays":    inSampleDays,
+		"outOfSampleDays": outOfSampleDays,
+		"anchored":        req.Anchored,
+	}).Info("🚀 Starting walk-forward analysis")
+
+	go s.runWalkForwardJob(walkForwardID, req.Optimization.Backtest, strat, o, windows)
+
+	return proto.Clone(result.Status).(*pb.WalkForwardStatus), nil
+}
+
+func (s *Server) GetWalkForwardResult(ctx context.Context, req *pb.WalkForwardResultRequest) (*pb.WalkForwardResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, exists := s.walkForwards[req.WalkForwardId]
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "walk-forward analysis not found")
+	}
+	return proto.Clone(result).(*pb.WalkForwardResult), nil
+}
+
+func (s *Server) runWalkForwardJob(walkForwardID string, req *pb.BacktestRequest, strat strategy.Strategy, o *optimizer, windows []walkForwardWindow) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	data, err := loadMarketData(ctx, s.Clients.DataClient, req, strat)
+	if err != nil {
+		s.failWalkForward(walkForwardID, err)
+		return
+	}
+	o.data = data
+
+	capital := o.base.InitialCapital
+	var equity []*pb.EquityPoint
+	for i, w := range windows {
+		window, outcome := runWalkForwardWindow(o, i+1, w, capital)
+		if outcome != nil {
+			capital = outcome.FinalValue
+			equity = append(equity, outcome.Equity...)
+		}
+		s.Logger.WithFields(log.Fields{
+			"walkForwardId": walkForwardID,
+			"window":        window.Window,
+			"parameters":    window.Parameters,
+			"inSample":      window.InSampleObjective,
+			"outOfSample":   window.OutOfSampleObjective,
+			"error":         window.Error,
+		}).Debug("🔍 Walk-forward window completed")
+
+		s.mu.Lock()
+		result := s.walkForwards[walkForwardID]
+		result.Windows = append(result.Windows, window)
+		result.Status.CompletedWindows = int32(i + 1)
+		result.Status.Progress = float64(i+1) / float64(len(windows)) * 100
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := s.walkForwards[walkForwardID]
+	result.Status.Status = statusCompleted
+	s.finishJob(walkForwardID)
+	result.EquityCurve = equity
+	result.FinalPortfolioValue = capital
+	result.TotalReturn = capital/o.base.InitialCapital - 1
+	result.SharpeRatio, result.Cagr, result.MaxDrawdown = equityMetrics(equity, o.base.InitialCapital)
+	result.Efficiency = walkForwardEfficiency(result.Windows)
+	result.Stability = parameterStability(o.space, result.Windows)
+	s.Logger.WithFields(log.Fields{
+		"walkForwardId": walkForwardID,
+		"totalReturn":   result.TotalReturn,
+		"sharpe":        result.SharpeRatio,
+		"efficiency":    result.Efficiency,
+	}).Info("✅ Walk-forward analysis completed")
+}
+
+// runWalkForwardWindow optimizes on the window's in-sample period and backtests the best
+// parameters out of sample. The outcome is nil when the window failed.
+func runWalkForwardWindow(o *optimizer, number int, w walkForwardWindow, capital float64) (*pb.WalkForwardWindow, *BacktestOutcome) {
+	window := &pb.WalkForwardWindow{
+		Window:           int32(number),
+		InSampleStart:    w.inSampleStart.Format(dateLayout),
+		InSampleEnd:      lastDay(w.inSampleEnd),
+		OutOfSampleStart: w.outOfSampleStart.Format(dateLayout),
+		OutOfSampleEnd:   lastDay(w.outOfSampleEnd),
+	}
+
+	inSample := o.forPeriod(w.inSampleStart, w.inSampleEnd.Add(-time.Second))
+	trials := inSample.run()
+	sortTrials(trials)
+	if len(trials) == 0 || trials[0].Error != "" {
+		window.Error = "no in-sample trial succeeded"
+		if len(trials) > 0 {
+			window.Error = fmt.Sprintf("no in-sample trial succeeded: %s", trials[0].Error)
+		}
+		return window, nil
+	}
+	window.Parameters = trials[0].Parameters
+	window.InSampleObjective = trials[0].Objective
+
+	cfg := inSample.config(trials[0].Parameters)
+	cfg.Start, cfg.End = w.outOfSampleStart, w.outOfSampleEnd.Add(-time.Second)
+	cfg.InitialCapital = capital
+	outcome, err := runBacktest(cfg, o.data, nil)
+	if err != nil {
+		window.Error = err.Error()
+		return window, nil
+	}
+	window.OutOfSampleObjective = objectiveValue(o.objective, outcome)
+	window.OutOfSampleReturn = outcome.TotalReturn
+	window.OutOfSampleSharpeRatio = outcome.SharpeRatio
+	window.OutOfSampleMaxDrawdown = outcome.MaxDrawdown
+	return window, outcome
+}
+
+// walkForwardEfficiency compares how the chosen parameters did out of sample with how they did
+// in sample. Values near one suggest the in-sample results were not overfitted.
+func walkForwardEfficiency(windows []*pb.WalkForwardWindow) float64 {
+	var inSample, outOfSample float64
+	var n int
+	for _, w := range windows {
+		if w.Error == "" {
+			inSample += w.InSampleObjective
+			outOfSample += w.OutOfSampleObjective
+			n++
+		}
+	}
+	if n == 0 || inSample <= 0 {
+		return 0
+	}
+	return outOfSample / inSample
+}
+
+// parameterStability summarizes the value each searched parameter took across the successful
+// windows.
+func parameterStability(space []dimension, windows []*pb.WalkForwardWindow) []*pb.ParameterStability {
+	stability := make([]*pb.ParameterStability, 0, len(space))
+	for _, d := range space {
+		stat := &pb.ParameterStability{Name: d.name}
+		counts := make(map[string]int)
+		var numbers []float64
+		for _, w := range windows {
+			if w.Error != "" {
+				continue
+			}
+			value := w.Parameters[d.name]
+			stat.Values = append(stat.Values, value)
+			counts[value]++
+			if counts[value] > counts[stat.MostFrequent] || (counts[value] == counts[stat.MostFrequent] && value < stat.MostFrequent) {
+				stat.MostFrequent = value
+			}
+			if number, err := strconv.ParseFloat(value, 64); err == nil && len(d.values) == 0 {
+				numbers = append(numbers, number)
+			}
+		}
+		stat.DistinctValues = int32(len(counts))
+		if len(stat.Values) > 0 {
+			stat.MostFrequentShare = float64(counts[stat.MostFrequent]) / float64(len(stat.Values))
+		}
+		if len(numbers) > 0 {
+			stat.Mean, stat.StdDev = meanStd(numbers)
+			if stat.Mean != 0 {
+				stat.CoefficientOfVariation = stat.StdDev / math.Abs(stat.Mean)
+			}
+		}
+		stability = append(stability, stat)
+	}
+	return stability
+}
+
+func (s *Server) failWalkForward(walkForwardID string, err error) {
+	s.Logger.WithError(err).WithField("walkForwardId", walkForwardID).Error("❌ Walk-forward analysis failed")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := s.walkForwards[walkForwardID]
+	result.Status.Status = statusFailed
+	result.Status.Error = err.Error()
+	s.finishJob(walkForwardID)
+}
@@ -23,7 +23,10 @@ CREATE TABLE IF NOT EXISTS stock_data (
 
 // Add this function
 func (s *Server) initDatabase() error {
-	_, err := s.DB.Exec(createTableSQL)
+	if _, err := s.DB.Exec(createTableSQL); err != nil {
+		return err
+	}
+	_, err := s.DB.Exec(createMetadataTableSQL)
 	return err
 }
 
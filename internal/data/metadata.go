@@ -0,0 +1,254 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/data_service"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const createMetadataTableSQL = `
+CREATE TABLE IF NOT EXISTS security_metadata (
+    symbol VARCHAR(10) PRIMARY KEY,
+    name TEXT NOT NULL DEFAULT '',
+    sector TEXT NOT NULL DEFAULT '',
+    industry TEXT NOT NULL DEFAULT '',
+    exchange TEXT NOT NULL DEFAULT '',
+    asset_type TEXT NOT NULL DEFAULT '',
+    market_cap DOUBLE PRECISION NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);`
+
+// metadataTTL is how long stored metadata is served before it is refreshed from Yahoo Finance.
+// Sector classifications rarely change, so this is much longer than the price cache.
+const metadataTTL = 30 * 24 * time.Hour
+
+// metadataFetchWorkers is the number of symbols whose metadata is fetched from Yahoo Finance at a time.
+const metadataFetchWorkers = 8
+
+type yahooQuoteSummaryResponse struct {
+	QuoteSummary struct {
+		Result []struct {
+			AssetProfile struct {
+				Sector   string `json:"sector"`
+				Industry string `json:"industry"`
+			} `json:"assetProfile"`
+			Price struct {
+				LongName     string `json:"longName"`
+				ShortName    string `json:"shortName"`
+				ExchangeName string `json:"exchangeName"`
+				QuoteType    string `json:"quoteType"`
+				MarketCap    struct {
+					Raw float64 `json:"raw"`
+				} `json:"marketCap"`
+			} `json:"price"`
+		} `json:"result"`
+	} `json:"quoteSummary"`
+}
+
+// GetSecurityMetadata returns sector, industry and listing information for the symbols. Stored
+// metadata is served while fresh; missing or stale entries are fetched from Yahoo Finance.
+func (s *Server) GetSecurityMetadata(ctx context.Context, req *pb.SecurityMetadataRequest) (*pb.SecurityMetadataResponse, error) {
+	s.Logger.WithField("symbols", req.Symbols).Info("Received request for security metadata")
+
+	stored, err := s.getSecurityMetadataFromDB(req.Symbols)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to read security metadata from database")
+		return nil, status.Errorf(codes.Internal, "failed to read security metadata: %v", err)
+	}
+
+	metadata := make(map[string]*pb.SecurityMetadata, len(req.Symbols))
+	var refresh []string
+	for _, symbol := range req.Symbols {
+		if md, ok := stored[symbol]; ok && time.Since(time.Unix(md.UpdatedAt, 0)) < metadataTTL {
+			metadata[symbol] = md
+			continue
+		}
+		refresh = append(refresh, symbol)
+	}
+
+	// Each worker writes only the result of the symbol it claimed, so no lock is needed
+	results := make([]*pb.SecurityMetadata, len(refresh))
+	fetchErrors := make([]error, len(refresh))
+	symbols := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(metadataFetchWorkers, len(refresh)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range symbols {
+				results[i], fetchErrors[i] = s.fetchSecurityMetadata(ctx, refresh[i])
+			}
+		}()
+	}
+	for i := range refresh {
+		symbols <- i
+	}
+	close(symbols)
+	wg.Wait()
+
+	errors := make(map[string]string)
+	var fetched []*pb.SecurityMetadata
+	for i, sym := range refresh {
+		if err := fetchErrors[i]; err != nil {
+			if old, ok := stored[sym]; ok {
+				// Stale metadata is better than none
+				s.Logger.WithError(err).WithField("symbol", sym).Warn("Failed to refresh security metadata, serving stored copy")
+				metadata[sym] = old
+				continue
+			}
+			s.Logger.WithError(err).WithField("symbol", sym).Error("Failed to fetch security metadata")
+			errors[sym] = err.Error()
+			continue
+		}
+		metadata[sym] = results[i]
+		fetched = append(fetched, results[i])
+	}
+	if len(fetched) > 0 {
+		if err := s.storeSecurityMetadataInDB(fetched); err != nil {
+			s.Logger.WithError(err).Error("Failed to store security metadata in database")
+		}
+	}
+
+	return &pb.SecurityMetadataResponse{
+		Metadata: metadata,
+		Errors:   errors,
+	}, nil
+}
+
+// UpdateSecurityMetadata stores caller-supplied metadata, replacing what is stored for those symbols.
+func (s *Server) UpdateSecurityMetadata(ctx context.Context, req *pb.UpdateSecurityMetadataRequest) (*pb.UpdateSecurityMetadataResponse, error) {
+	s.Logger.WithField("count", len(req.Metadata)).Info("Updating security metadata")
+
+	for _, md := range req.Metadata {
+		if md.Symbol == "" {
+			return nil, status.Error(codes.InvalidArgument, "every metadata entry needs a symbol")
+		}
+		md.UpdatedAt = time.Now().Unix()
+	}
+
+	if err := s.storeSecurityMetadataInDB(req.Metadata); err != nil {
+		return &pb.UpdateSecurityMetadataResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to store security metadata: %v", err),
+		}, nil
+	}
+
+	return &pb.UpdateSecurityMetadataResponse{
+		Success: true,
+		Message: fmt.Sprintf("Stored metadata for %d symbols", len(req.Metadata)),
+	}, nil
+}
+
+func (s *Server) fetchSecurityMetadata(ctx context.Context, symbol string) (*pb.SecurityMetadata, error) {
+	url := fmt.Sprintf("https://query2.finance.yahoo.com/v10/finance/quoteSummary/%s?modules=assetProfile,price", symbol)
+
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	resp, err := s.HttpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch metadata: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received non-200 response: %d", resp.StatusCode)
+	}
+
+	var summary yahooQuoteSummaryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %v", err)
+	}
+	if len(summary.QuoteSummary.Result) == 0 {
+		return nil, fmt.Errorf("no metadata found for symbol: %s", symbol)
+	}
+
+	result := summary.QuoteSummary.Result[0]
+	name := result.Price.LongName
+	if name == "" {
+		name = result.Price.ShortName
+	}
+	s.Logger.WithFields(log.Fields{
+		"symbol": symbol,
+		"sector": result.AssetProfile.Sector,
+	}).Info("Fetched security metadata")
+
+	return &pb.SecurityMetadata{
+		Symbol:    symbol,
+		Name:      name,
+		Sector:    result.AssetProfile.Sector,
+		Industry:  result.AssetProfile.Industry,
+		Exchange:  result.Price.ExchangeName,
+		AssetType: result.Price.QuoteType,
+		MarketCap: result.Price.MarketCap.Raw,
+		UpdatedAt: time.Now().Unix(),
+	}, nil
+}
+
+func (s *Server) getSecurityMetadataFromDB(symbols []string) (map[string]*pb.SecurityMetadata, error) {
+	metadata := make(map[string]*pb.SecurityMetadata)
+	if len(symbols) == 0 {
+		return metadata, nil
+	}
+
+	placeholders := make([]string, len(symbols))
+	args := make([]interface{}, len(symbols))
+	for i, symbol := range symbols {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = symbol
+	}
+	query := fmt.Sprintf(`SELECT symbol, name, sector, industry, exchange, asset_type, market_cap, updated_at
+              FROM security_metadata
+              WHERE symbol IN (%s)`, strings.Join(placeholders, ", "))
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var md pb.SecurityMetadata
+		var updatedAt time.Time
+		if err := rows.Scan(&md.Symbol, &md.Name, &md.Sector, &md.Industry, &md.Exchange, &md.AssetType, &md.MarketCap, &updatedAt); err != nil {
+			return nil, err
+		}
+		md.UpdatedAt = updatedAt.Unix()
+		metadata[md.Symbol] = &md
+	}
+	return metadata, rows.Err()
+}
+
+func (s *Server) storeSecurityMetadataInDB(metadata []*pb.SecurityMetadata) error {
+	query := `INSERT INTO security_metadata (symbol, name, sector, industry, exchange, asset_type, market_cap, updated_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+              ON CONFLICT (symbol) DO UPDATE
+              SET name = $2, sector = $3, industry = $4, exchange = $5, asset_type = $6, market_cap = $7, updated_at = $8`
+
+	tx, err := s.DB.Begin()
+	if err != nil {
+		return err
+	}
+
+	for _, md := range metadata {
+		_, err := tx.Exec(query, md.Symbol, md.Name, md.Sector, md.Industry, md.Exchange, md.AssetType, md.MarketCap, time.Unix(md.UpdatedAt, 0))
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,334 @@
+// internal/portfolio/approval.go
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/portfolio_service"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Statuses of a rebalance that needs approval before its orders are submitted
+const (
+	rebalancePendingApproval = "PENDING_APPROVAL"
+	rebalanceApproved        = "APPROVED"
+	rebalanceRejected        = "REJECTED"
+	rebalanceExpired         = "EXPIRED"
+
+	decisionApproved = "APPROVED"
+	decisionRejected = "REJECTED"
+)
+
+// propose turns a planned run into a proposal awaiting approval. Only the newest proposal can be
+// approved, so any older one still pending is expired.
+func (p *Portfolio) propose(record *pb.RebalanceRecord, config *pb.PortfolioConfiguration) {
+	p.expireProposals(record.Id)
+
+	expiresAt := time.Now().Add(time.Duration(config.ApprovalExpiryMinutes) * time.Minute)
+	record.Status = rebalancePendingApproval
+	record.ExpiresAt = expiresAt.UTC().Format(time.RFC3339)
+	p.Logger.WithFields(log.Fields{
+		"rebalanceId": record.Id,
+		"orderCount":  len(record.Plan.GetOrders()),
+		"expiresAt":   record.ExpiresAt,
+	}).Info("📝 Rebalance proposal awaiting approval")
+}
+
+// expireProposals expires pending proposals past their expiry, or every pending proposal but the
+// one with the supersededBy id when it is set. Proposals are expired lazily, whenever runs are
+// read or decided on, so a stale proposal can never be approved.
+func (p *Portfolio) expireProposals(supersededBy string) {
+	pending, err := p.loadRebalanceRecordsByStatus(rebalancePendingApproval)
+	if err != nil {
+		p.Logger.WithError(err).Error("❌ Failed to load pending rebalance proposals")
+		return
+	}
+
+	now := time.Now()
+	for _, record := range pending {
+		var reason string
+		switch {
+		case supersededBy != "" && record.Id != supersededBy:
+			reason = "superseded by proposal " + supersededBy
+		case proposalExpired(record, now):
+			reason = "approval expired at " + record.ExpiresAt
+		default:
+			continue
+		}
+		p.expireProposal(record, reason, now)
+	}
+}
+
+func (p *Portfolio) expireProposal(record *pb.RebalanceRecord, reason string, now time.Time) {
+	record.Status = rebalanceExpired
+	record.Error = reason
+	record.FinishedAt = now.UTC().Format(time.RFC3339)
+	updated, err := p.transitionRebalanceRecord(record, rebalancePendingApproval)
+	if err != nil {
+		p.Logger.WithError(err).WithField("rebalanceId", record.Id).Error("❌ Failed to expire rebalance proposal")
+		return
+	}
+	if updated {
+		p.Logger.WithFields(log.Fields{
+			"rebalanceId": record.Id,
+			"reason":      reason,
+		}).Warn("⌛ Rebalance proposal expired")
+	}
+}
+
+// proposalExpired reports whether a proposal is past its expiry. A proposal without a readable
+// expiry is treated as expired.
+func proposalExpired(record *pb.RebalanceRecord, now time.Time) bool {
+	expiresAt, err := time.Parse(time.RFC3339Nano, record.ExpiresAt)
+	return err != nil || !now.Before(expiresAt)
+}
+
+// pendingProposal loads a proposal that can still be decided on, expiring it when it is stale.
+func (p *Portfolio) pendingProposal(id string) (*pb.RebalanceRecord, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "rebalance id is required")
+	}
+	record, err := p.loadRebalanceRecord(id)
+	if err != nil {
+		p.Logger.WithError(err).Error("❌ Failed to load rebalance run")
+		return nil, status.Errorf(codes.Internal, "failed to load rebalance run: %v", err)
+	}
+	if record == nil {
+		return nil, status.Errorf(codes.NotFound, "rebalance run %s not found", id)
+	}
+	if record.Status != rebalancePendingApproval {
+		return nil, status.Errorf(codes.FailedPrecondition, "rebalance run %s is %s, not pending approval", id, record.Status)
+	}
+	if now := time.Now(); proposalExpired(record, now) {
+		p.expireProposal(record, "approval expired at "+record.ExpiresAt, now)
+		return nil, status.Errorf(codes.FailedPrecondition, "rebalance proposal %s expired at %s", id, record.ExpiresAt)
+	}
+	return record, nil
+}
+
+// ApproveRebalance releases a pending proposal's orders, optionally only some of them or with
+// edited quantities, and executes them against the current account.
+func (p *Portfolio) ApproveRebalance(ctx context.Context, req *pb.ApproveRebalanceRequest) (*pb.ApproveRebalanceResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	record, err := p.pendingProposal(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := approvedOrders(record.Plan.GetOrders(), req.Symbols, req.OrderOverrides)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid approval: %v", err)
+	}
+
+	record.Status = rebalanceApproved
+	record.Approval = &pb.RebalanceApproval{
+		Decision:       decisionApproved,
+		DecidedBy:      req.ApprovedBy,
+		Comment:        req.Comment,
+		DecidedAt:      time.Now().UTC().Format(time.RFC3339),
+		ApprovedOrders: orders,
+	}
+	claimed, err := p.transitionRebalanceRecord(record, rebalancePendingApproval)
+	if err != nil {
+		p.Logger.WithError(err).Error("❌ Failed to store rebalance approval")
+		return nil, status.Errorf(codes.Internal, "failed to store rebalance approval: %v", err)
+	}
+	if !claimed {
+		return nil, status.Errorf(codes.FailedPrecondition, "rebalance proposal %s was decided on concurrently", req.Id)
+	}
+	p.Logger.WithFields(log.Fields{
+		"rebalanceId": record.Id,
+		"approvedBy":  req.ApprovedBy,
+		"orderCount":  len(orders),
+	}).Info("✅ Rebalance proposal approved")
+
+	err = p.executeApproved(ctx, record, orders)
+	p.finishRebalance(ctx, record, err)
+	if err != nil {
+		return &pb.ApproveRebalanceResponse{
+			Success:   false,
+			Message:   "Failed to execute approved rebalance: " + err.Error(),
+			Rebalance: record,
+		}, nil
+	}
+	return &pb.ApproveRebalanceResponse{
+		Success:   true,
+		Message:   "Approved rebalance executed successfully",
+		Rebalance: record,
+	}, nil
+}
+
+// executeApproved submits approved orders against the account as it is now, which may have moved
+// since the proposal was made. Sells are limited to the quantities still held.
+func (p *Portfolio) executeApproved(ctx context.Context, record *pb.RebalanceRecord, orders []*pb.Order) error {
+	current, err := p.getCurrentPortfolioState(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current portfolio state: %w", err)
+	}
+	orders, limited := limitSells(orders, current)
+	if len(limited) > 0 {
+		p.Logger.WithField("symbols", limited).Warn("❗ Approved sells exceed current holdings, limiting them")
+	}
+
+	plan := &rebalancePlan{
+		current: current,
+		target:  applyOrders(current, orders),
+		orders:  orders,
+	}
+	record.Phases, err = p.submitPlan(ctx, plan)
+	if err != nil {
+		return fmt.Errorf("failed to submit orders: %w", err)
+	}
+	return nil
+}
+
+// RejectRebalance discards a pending proposal without submitting any of its orders.
+func (p *Portfolio) RejectRebalance(ctx context.Context, req *pb.RejectRebalanceRequest) (*pb.RejectRebalanceResponse, error) {
+	record, err := p.pendingProposal(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	decidedAt := time.Now()
+	record.Status = rebalanceRejected
+	record.FinishedAt = decidedAt.UTC().Format(time.RFC3339)
+	record.Approval = &pb.RebalanceApproval{
+		Decision:  decisionRejected,
+		DecidedBy: req.RejectedBy,
+		Comment:   req.Comment,
+		DecidedAt: record.FinishedAt,
+	}
+	rejected, err := p.transitionRebalanceRecord(record, rebalancePendingApproval)
+	if err != nil {
+		p.Logger.WithError(err).Error("❌ Failed to store rebalance rejection")
+		return nil, status.Errorf(codes.Internal, "failed to store rebalance rejection: %v", err)
+	}
+	if !rejected {
+		return nil, status.Errorf(codes.FailedPrecondition, "rebalance proposal %s was decided on concurrently", req.Id)
+	}
+	p.recordRebalance(decidedAt, rebalanceRejected, req.Comment)
+
+	p.Logger.WithFields(log.Fields{
+		"rebalanceId": record.Id,
+		"rejectedBy":  req.RejectedBy,
+	}).Info("🚫 Rebalance proposal rejected")
+	return &pb.RejectRebalanceResponse{
+		Success:   true,
+		Message:   "Rebalance proposal rejected",
+		Rebalance: record,
+	}, nil
+}
+
+// approvedOrders returns the proposed orders an approval releases: only those for the approved
+// symbols when any are given, at their overridden quantities. Overrides can shrink or drop
+// proposed orders but not add orders, grow them beyond what the risk rules let through or turn a
+// buy into a sell.
+func approvedOrders(proposed []*pb.Order, symbols []string, overrides []*pb.Order) ([]*pb.Order, error) {
+	bySymbol := make(map[string]*pb.Order, len(proposed))
+	for _, order := range proposed {
+		bySymbol[order.Symbol] = order
+	}
+
+	approved := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		if _, ok := bySymbol[symbol]; !ok {
+			return nil, fmt.Errorf("the proposal has no order for %s", symbol)
+		}
+		approved[symbol] = true
+	}
+
+	quantities := make(map[string]int32, len(overrides))
+	for _, override := range overrides {
+		order, ok := bySymbol[override.Symbol]
+		if !ok {
+			return nil, fmt.Errorf("the proposal has no order for %s", override.Symbol)
+		}
+		if len(approved) > 0 && !approved[override.Symbol] {
+			return nil, fmt.Errorf("override for %s, which is not among the approved symbols", override.Symbol)
+		}
+		if _, dup := quantities[override.Symbol]; dup {
+			return nil, fmt.Errorf("more than one override for %s", override.Symbol)
+		}
+		if override.Quantity != 0 && (override.Quantity > 0) != (order.Quantity > 0) {
+			return nil, fmt.Errorf("override for %s changes the side of the order", override.Symbol)
+		}
+		if (order.Quantity > 0 && override.Quantity > order.Quantity) || (order.Quantity < 0 && override.Quantity < order.Quantity) {
+			return nil, fmt.Errorf("override for %s of %d exceeds the proposed quantity %d", override.Symbol, override.Quantity, order.Quantity)
+		}
+		quantities[override.Symbol] = override.Quantity
+	}
+
+	var orders []*pb.Order
+	for _, order := range proposed {
+		if len(approved) > 0 && !approved[order.Symbol] {
+			continue
+		}
+		quantity, overridden := quantities[order.Symbol]
+		if !overridden {
+			quantity = order.Quantity
+		}
+		if quantity == 0 {
+			continue
+		}
+		orders = append(orders, &pb.Order{
+			Symbol:   order.Symbol,
+			Type:     order.Type,
+			Quantity: quantity,
+			Price:    order.Price,
+		})
+	}
+	return orders, nil
+}
+
+// limitSells caps sells at the quantity currently held, dropping sells of symbols no longer held,
+// and returns the symbols it changed.
+func limitSells(orders []*pb.Order, current *accountState) ([]*pb.Order, []string) {
+	var limitedOrders []*pb.Order
+	var limited []string
+	for _, order := range orders {
+		held := current.positions[order.Symbol].GetQuantity()
+		if order.Quantity >= 0 || -order.Quantity <= held {
+			limitedOrders = append(limitedOrders, order)
+			continue
+		}
+		limited = append(limited, order.Symbol)
+		if held > 0 {
+			limitedOrders = append(limitedOrders, &pb.Order{
+				Symbol:   order.Symbol,
+				Type:     order.Type,
+				Quantity: -held,
+				Price:    order.Price,
+			})
+		}
+	}
+	return limitedOrders, limited
+}
+
+// applyOrders returns the positions the account holds once the orders fill, priced at the order
+// prices.
+func applyOrders(current *accountState, orders []*pb.Order) map[string]*pb.Position {
+	target := make(map[string]*pb.Position, len(current.positions))
+	for symbol, pos := range current.positions {
+		target[symbol] = pos
+	}
+	for _, order := range orders {
+		quantity := current.positions[order.Symbol].GetQuantity() + order.Quantity
+		if quantity <= 0 {
+			delete(target, order.Symbol)
+			continue
+		}
+		target[order.Symbol] = &pb.Position{
+			Symbol:       order.Symbol,
+			Quantity:     quantity,
+			CurrentPrice: order.Price,
+			MarketValue:  float64(quantity) * order.Price,
+		}
+	}
+	return target
+}
@@ -0,0 +1,122 @@
+// internal/portfolio/bands.go
+package portfolio
+
+import (
+	"fmt"
+	"math"
+
+	pb "momentum-trading-platform/api/proto/portfolio_service"
+	strategypb "momentum-trading-platform/api/proto/strategy_service"
+)
+
+// Reasons reported for positions that are left as they are instead of trading to their allocation
+const (
+	keptBufferRank    = "within buffer rank"
+	keptWithinBand    = "within drift band"
+	keptBelowMinTrade = "below minimum trade value"
+)
+
+func validateRebalanceBands(bands *pb.RebalanceBands) error {
+	if bands.GetAbsoluteDrift() < 0 || bands.GetAbsoluteDrift() > 1 {
+		return fmt.Errorf("absolute_drift must be between 0 and 1")
+	}
+	if bands.GetRelativeDrift() < 0 {
+		return fmt.Errorf("relative_drift must not be negative")
+	}
+	if bands.GetMinTradeValue() < 0 {
+		return fmt.Errorf("min_trade_value must not be negative")
+	}
+	if bands.GetBufferRank() < 0 {
+		return fmt.Errorf("buffer_rank must not be negative")
+	}
+	return nil
+}
+
+// bufferedHoldings returns the holdings the strategy no longer selects but still ranks within the
+// buffer rank, at their current quantities. A holding with any signal, including an explicit
+// SELL, follows its signal instead, and nothing is buffered when the market regime moves the
+// portfolio to cash.
+func bufferedHoldings(bands *pb.RebalanceBands, signalResp *strategypb.SignalResponse, current *accountState) map[string]*pb.Position {
+	bufferRank := bands.GetBufferRank()
+	if bufferRank <= 0 || signalResp.RegimeAction == strategypb.RegimeAction_CASH {
+		return nil
+	}
+
+	signaled := make(map[string]bool, len(signalResp.Signals))
+	for _, signal := range signalResp.Signals {
+		signaled[signal.Symbol] = true
+	}
+
+	buffered := make(map[string]*pb.Position)
+	for _, diagnostic := range signalResp.Diagnostics {
+		pos, held := current.positions[diagnostic.Symbol]
+		if !held || signaled[diagnostic.Symbol] || diagnostic.Rank <= 0 || diagnostic.Rank > bufferRank {
+			continue
+		}
+		buffered[diagnostic.Symbol] = &pb.Position{
+			Symbol:       pos.Symbol,
+			Quantity:     pos.Quantity,
+			CurrentPrice: pos.CurrentPrice,
+			MarketValue:  pos.MarketValue,
+		}
+	}
+	return buffered
+}
+
+// applyBands leaves positions whose move to the target is immaterial at their current quantity,
+// and returns why each was kept and the traded value that was avoided. Exits always trade.
+func applyBands(bands *pb.RebalanceBands, current *accountState, target map[string]*pb.Position) (map[string]string, float64) {
+	kept := make(map[string]string)
+	totalValue := current.totalValue()
+	if bands == nil || totalValue <= 0 {
+		return kept, 0
+	}
+
+	before := tradedValue(current.positions, target)
+	for _, symbol := range unionSymbols(current.positions, target) {
+		currentQuantity, targetQuantity, price := positionChange(current.positions, target, symbol)
+		if currentQuantity == targetQuantity || targetQuantity == 0 {
+			continue
+		}
+
+		var reason string
+		currentWeight := float64(currentQuantity) * price / totalValue
+		targetWeight := float64(targetQuantity) * price / totalValue
+		if math.Abs(float64(targetQuantity-currentQuantity))*price < bands.MinTradeValue {
+			reason = keptBelowMinTrade
+		} else if currentQuantity > 0 && withinBands(bands, currentWeight, targetWeight) {
+			reason = keptWithinBand
+		} else {
+			continue
+		}
+
+		kept[symbol] = reason
+		if currentQuantity == 0 {
+			delete(target, symbol)
+			continue
+		}
+		target[symbol] = &pb.Position{
+			Symbol:       symbol,
+			Quantity:     currentQuantity,
+			CurrentPrice: price,
+			MarketValue:  float64(currentQuantity) * price,
+		}
+	}
+	return kept, before - tradedValue(current.positions, target)
+}
+
+// withinBands reports whether a weight is close enough to its target that it should not be
+// traded. Crossing either configured band triggers a trade.
+func withinBands(bands *pb.RebalanceBands, currentWeight, targetWeight float64) bool {
+	if bands.AbsoluteDrift <= 0 && bands.RelativeDrift <= 0 {
+		return false
+	}
+	drift := math.Abs(currentWeight - targetWeight)
+	if bands.AbsoluteDrift > 0 && drift > bands.AbsoluteDrift {
+		return false
+	}
+	if bands.RelativeDrift > 0 && drift > bands.RelativeDrift*targetWeight {
+		return false
+	}
+	return true
+}
@@ -37,11 +37,17 @@ func NewClients() (*Clients, error) {
 		return nil, fmt.Errorf("failed to connect to portfolio state service: %v", err)
 	}
 
+	tradeExecutionConn, err := grpc.NewClient("localhost:50056", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to trade execution service: %v", err)
+	}
+
 	return &Clients{
 		DataClient:           datapb.NewDataServiceClient(dataConn),
 		StrategyClient:       strategypb.NewStrategyServiceClient(strategyConn),
 		PortfolioStateClient: portfoliostatepb.NewPortfolioStateServiceClient(portfolioStateConn),
-		connections:          []*grpc.ClientConn{dataConn, strategyConn, portfolioStateConn},
+		TradeExecutionClient: tradepb.NewTradeExecutionServiceClient(tradeExecutionConn),
+		connections:          []*grpc.ClientConn{dataConn, strategyConn, portfolioStateConn, tradeExecutionConn},
 	}, nil
 }
 
@@ -0,0 +1,191 @@
+// internal/portfolio/config.go
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/portfolio_service"
+	strategypb "momentum-trading-platform/api/proto/strategy_service"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultMarketIndex  = "^GSPC"
+	defaultStrategyName = "momentum"
+	defaultLookbackDays = 400 // Covers the longest default lookback, the 200-day regime average, with room for holidays
+	defaultInterval     = "1d"
+	maxLookbackDays     = 3650
+	defaultCostBps      = 10
+
+	defaultApprovalExpiryMinutes = 360 // Proposals go stale within the trading day they were made
+	maxApprovalExpiryMinutes     = 7 * 24 * 60
+)
+
+// normalizeConfiguration returns a copy of the configuration with defaults filled in and the
+// universe cleaned up, or an error when a setting is invalid.
+func normalizeConfiguration(config *pb.PortfolioConfiguration) (*pb.PortfolioConfiguration, error) {
+	normalized := &pb.PortfolioConfiguration{}
+	if config != nil {
+		normalized = proto.Clone(config).(*pb.PortfolioConfiguration)
+	}
+
+	seen := make(map[string]bool, len(normalized.Universe))
+	universe := make([]string, 0, len(normalized.Universe))
+	for _, symbol := range normalized.Universe {
+		symbol = strings.ToUpper(strings.TrimSpace(symbol))
+		if symbol != "" && !seen[symbol] {
+			seen[symbol] = true
+			universe = append(universe, symbol)
+		}
+	}
+	normalized.Universe = universe
+
+	if normalized.MarketIndex == "" {
+		normalized.MarketIndex = defaultMarketIndex
+	}
+	if normalized.StrategyName == "" {
+		normalized.StrategyName = defaultStrategyName
+	}
+	if normalized.LookbackDays == 0 {
+		normalized.LookbackDays = defaultLookbackDays
+	}
+	if normalized.LookbackDays < 0 || normalized.LookbackDays > maxLookbackDays {
+		return nil, fmt.Errorf("lookback_days must be between 1 and %d", maxLookbackDays)
+	}
+	if normalized.CostBps == 0 {
+		normalized.CostBps = defaultCostBps
+	}
+	if normalized.CostBps < 0 {
+		return nil, fmt.Errorf("cost_bps must not be negative")
+	}
+	if normalized.ApprovalExpiryMinutes == 0 {
+		normalized.ApprovalExpiryMinutes = defaultApprovalExpiryMinutes
+	}
+	if normalized.ApprovalExpiryMinutes < 0 || normalized.ApprovalExpiryMinutes > maxApprovalExpiryMinutes {
+		return nil, fmt.Errorf("approval_expiry_minutes must be between 1 and %d", maxApprovalExpiryMinutes)
+	}
+	if err := validateRiskRules(normalized.RiskRules); err != nil {
+		return nil, err
+	}
+	if err := validateRebalanceBands(normalized.RebalanceBands); err != nil {
+		return nil, err
+	}
+	if err := normalizeOptimizer(normalized.Optimizer); err != nil {
+		return nil, err
+	}
+	switch normalized.Interval {
+	case "":
+		normalized.Interval = defaultInterval
+	case "1d", "1wk", "1mo":
+	default:
+		return nil, fmt.Errorf("interval must be 1d, 1wk or 1mo")
+	}
+	return normalized, nil
+}
+
+// validateStrategy asks the strategy service to check the strategy, its parameters and the
+// universe, which may only be empty when the strategy trades a default universe of its own.
+func (p *Portfolio) validateStrategy(ctx context.Context, config *pb.PortfolioConfiguration) error {
+	if _, err := p.Clients.StrategyClient.ValidateStrategy(ctx, &strategypb.ValidateStrategyRequest{
+		StrategyName: config.StrategyName,
+		Parameters:   config.StrategyParameters,
+		Symbols:      config.Universe,
+	}); err != nil {
+		return fmt.Errorf("strategy %s rejected the configuration: %s", config.StrategyName, status.Convert(err).Message())
+	}
+	return nil
+}
+
+func (p *Portfolio) loadConfiguration() error {
+	config, err := p.loadPortfolioConfiguration()
+	if err != nil {
+		return fmt.Errorf("failed to load portfolio configuration: %v", err)
+	}
+	normalized, err := normalizeConfiguration(config)
+	if err != nil {
+		p.Logger.WithError(err).Warn("❗ Stored portfolio configuration is invalid, using the defaults")
+		normalized, _ = normalizeConfiguration(nil)
+	}
+	config = normalized
+
+	p.settingsMu.Lock()
+	p.Configuration = config
+	p.settingsMu.Unlock()
+
+	p.Logger.WithFields(log.Fields{
+		"strategy":     config.StrategyName,
+		"universeSize": len(config.Universe),
+		"marketIndex":  config.MarketIndex,
+	}).Info("⚙️ Loaded portfolio configuration")
+	return nil
+}
+
+// configuration returns a copy of the current configuration.
+func (p *Portfolio) configuration() *pb.PortfolioConfiguration {
+	p.settingsMu.Lock()
+	defer p.settingsMu.Unlock()
+	return proto.Clone(p.Configuration).(*pb.PortfolioConfiguration)
+}
+
+// checkConfiguration normalizes a requested configuration and validates its strategy, stamping
+// it with the time of the update.
+func (p *Portfolio) checkConfiguration(ctx context.Context, requested *pb.PortfolioConfiguration) (*pb.PortfolioConfiguration, error) {
+	config, err := normalizeConfiguration(requested)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.validateStrategy(ctx, config); err != nil {
+		return nil, err
+	}
+	config.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	return config, nil
+}
+
+func (p *Portfolio) ConfigurePortfolio(ctx context.Context, req *pb.ConfigurePortfolioRequest) (*pb.ConfigurePortfolioResponse, error) {
+	config, err := p.checkConfiguration(ctx, req.Configuration)
+	if err != nil {
+		return &pb.ConfigurePortfolioResponse{
+			Success: false,
+			Message: "Invalid portfolio configuration: " + err.Error(),
+		}, nil
+	}
+
+	if err := p.storePortfolioConfiguration(config); err != nil {
+		p.Logger.WithError(err).Error("❌ Failed to store portfolio configuration")
+		return &pb.ConfigurePortfolioResponse{
+			Success: false,
+			Message: "Failed to store portfolio configuration: " + err.Error(),
+		}, nil
+	}
+
+	p.settingsMu.Lock()
+	p.Configuration = config
+	p.settingsMu.Unlock()
+
+	p.Logger.WithFields(log.Fields{
+		"strategy":     config.StrategyName,
+		"parameters":   config.StrategyParameters,
+		"universeSize": len(config.Universe),
+		"marketIndex":  config.MarketIndex,
+		"lookbackDays": config.LookbackDays,
+		"riskRules":    config.RiskRules,
+		"bands":        config.RebalanceBands,
+		"optimizer":    config.Optimizer.GetMethod(),
+	}).Info("⚙️ Portfolio configured")
+
+	return &pb.ConfigurePortfolioResponse{
+		Success:       true,
+		Message:       "Portfolio configured successfully",
+		Configuration: proto.Clone(config).(*pb.PortfolioConfiguration),
+	}, nil
+}
+
+func (p *Portfolio) GetPortfolioConfiguration(ctx context.Context, req *pb.GetPortfolioConfigurationRequest) (*pb.PortfolioConfiguration, error) {
+	return p.configuration(), nil
+}
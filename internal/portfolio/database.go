@@ -0,0 +1,349 @@
+// internal/portfolio/database.go
+package portfolio
+
+import (
+	"database/sql"
+	"math"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/portfolio_service"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Each portfolio has one schedule row and one configuration row, unique by portfolio id.
+const createTableSQL = `
+CREATE TABLE IF NOT EXISTS rebalance_schedule (
+    portfolio_id TEXT NOT NULL,
+    schedule TEXT NOT NULL,
+    last_run TIMESTAMPTZ,
+    last_status TEXT,
+    last_error TEXT,
+    next_run TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE IF NOT EXISTS portfolio_config (
+    portfolio_id TEXT NOT NULL,
+    configuration JSONB NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE IF NOT EXISTS rebalance_runs (
+    id TEXT PRIMARY KEY,
+    portfolio_id TEXT NOT NULL DEFAULT 'default',
+    trigger TEXT NOT NULL,
+    status TEXT NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+    order_count INTEGER NOT NULL DEFAULT 0,
+    turnover DOUBLE PRECISION NOT NULL DEFAULT 0,
+    error TEXT,
+    record JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS rebalance_runs_started_at_idx ON rebalance_runs (started_at DESC);
+-- Tables from before portfolios were kept apart hold the default portfolio, in single rows keyed by id 1
+ALTER TABLE rebalance_schedule ADD COLUMN IF NOT EXISTS portfolio_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE rebalance_schedule DROP COLUMN IF EXISTS id;
+ALTER TABLE portfolio_config ADD COLUMN IF NOT EXISTS portfolio_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE portfolio_config DROP COLUMN IF EXISTS id;
+ALTER TABLE rebalance_runs ADD COLUMN IF NOT EXISTS portfolio_id TEXT NOT NULL DEFAULT 'default';
+CREATE UNIQUE INDEX IF NOT EXISTS rebalance_schedule_portfolio_id_idx ON rebalance_schedule (portfolio_id);
+CREATE UNIQUE INDEX IF NOT EXISTS portfolio_config_portfolio_id_idx ON portfolio_config (portfolio_id);
+CREATE INDEX IF NOT EXISTS rebalance_runs_portfolio_started_at_idx ON rebalance_runs (portfolio_id, started_at DESC);`
+
+// scheduleState is the persisted state of the rebalance scheduler.
+type scheduleState struct {
+	schedule   string
+	lastRun    time.Time
+	lastStatus string
+	lastError  string
+	nextRun    time.Time
+}
+
+func (s *Server) initDatabase() error {
+	_, err := s.DB.Exec(createTableSQL)
+	return err
+}
+
+// loadPortfolioIDs returns the ids of the portfolios with a stored schedule or configuration.
+func (s *Server) loadPortfolioIDs() ([]string, error) {
+	rows, err := s.DB.Query(`SELECT portfolio_id FROM rebalance_schedule
+              UNION SELECT portfolio_id FROM portfolio_config
+              ORDER BY portfolio_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// loadScheduleState returns the persisted scheduler state, or nil when none has been stored.
+func (p *Portfolio) loadScheduleState() (*scheduleState, error) {
+	var state scheduleState
+	var lastRun, nextRun sql.NullTime
+	var lastStatus, lastError sql.NullString
+	err := p.DB.QueryRow(`SELECT schedule, last_run, last_status, last_error, next_run FROM rebalance_schedule WHERE portfolio_id = $1`, p.ID).
+		Scan(&state.schedule, &lastRun, &lastStatus, &lastError, &nextRun)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state.lastRun, state.nextRun = lastRun.Time, nextRun.Time
+	state.lastStatus, state.lastError = lastStatus.String, lastError.String
+	return &state, nil
+}
+
+func (p *Portfolio) storeScheduleState(state scheduleState) error {
+	query := `INSERT INTO rebalance_schedule (portfolio_id, schedule, last_run, last_status, last_error, next_run, updated_at)
+              VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP)
+              ON CONFLICT (portfolio_id) DO UPDATE SET
+                  schedule = EXCLUDED.schedule,
+                  last_run = EXCLUDED.last_run,
+                  last_status = EXCLUDED.last_status,
+                  last_error = EXCLUDED.last_error,
+                  next_run = EXCLUDED.next_run,
+                  updated_at = EXCLUDED.updated_at`
+	_, err := p.DB.Exec(query, p.ID, state.schedule, nullTime(state.lastRun), state.lastStatus, state.lastError, nullTime(state.nextRun))
+	return err
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+// loadPortfolioConfiguration returns the persisted configuration, or nil when none has been stored.
+func (p *Portfolio) loadPortfolioConfiguration() (*pb.PortfolioConfiguration, error) {
+	var configJSON []byte
+	err := p.DB.QueryRow(`SELECT configuration FROM portfolio_config WHERE portfolio_id = $1`, p.ID).Scan(&configJSON)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	config := &pb.PortfolioConfiguration{}
+	if err := protojson.Unmarshal(configJSON, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (p *Portfolio) storePortfolioConfiguration(config *pb.PortfolioConfiguration) error {
+	configJSON, err := protojson.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO portfolio_config (portfolio_id, configuration, updated_at)
+              VALUES ($1, $2, CURRENT_TIMESTAMP)
+              ON CONFLICT (portfolio_id) DO UPDATE SET
+                  configuration = EXCLUDED.configuration,
+                  updated_at = EXCLUDED.updated_at`
+	_, err = p.DB.Exec(query, p.ID, configJSON)
+	return err
+}
+
+// storeNewPortfolio stores the configuration of a portfolio being created and its schedule, when
+// it has one.
+func (p *Portfolio) storeNewPortfolio() error {
+	if err := p.storePortfolioConfiguration(p.Configuration); err != nil {
+		return err
+	}
+	if p.RebalanceSchedule == nil {
+		return nil
+	}
+	return p.storeScheduleState(p.scheduleState)
+}
+
+// deletePortfolio removes the portfolio's configuration and schedule, undoing a creation that
+// failed part way. Its rebalance runs are kept.
+func (p *Portfolio) deletePortfolio() error {
+	if _, err := p.DB.Exec(`DELETE FROM rebalance_schedule WHERE portfolio_id = $1`, p.ID); err != nil {
+		return err
+	}
+	_, err := p.DB.Exec(`DELETE FROM portfolio_config WHERE portfolio_id = $1`, p.ID)
+	return err
+}
+
+// storeRebalanceRecord inserts or replaces a rebalance run. The summary columns duplicate fields
+// of the record so that runs can be listed without reading the records.
+func (p *Portfolio) storeRebalanceRecord(record *pb.RebalanceRecord) error {
+	recordJSON, err := protojson.Marshal(record)
+	if err != nil {
+		return err
+	}
+	startedAt, err := time.Parse(time.RFC3339Nano, record.StartedAt)
+	if err != nil {
+		return err
+	}
+	var finishedAt sql.NullTime
+	if record.FinishedAt != "" {
+		t, err := time.Parse(time.RFC3339Nano, record.FinishedAt)
+		if err != nil {
+			return err
+		}
+		finishedAt = nullTime(t)
+	}
+
+	query := `INSERT INTO rebalance_runs (id, portfolio_id, trigger, status, started_at, finished_at, order_count, turnover, error, record)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+              ON CONFLICT (id) DO UPDATE SET
+                  status = EXCLUDED.status,
+                  finished_at = EXCLUDED.finished_at,
+                  order_count = EXCLUDED.order_count,
+                  turnover = EXCLUDED.turnover,
+                  error = EXCLUDED.error,
+                  record = EXCLUDED.record`
+	_, err = p.DB.Exec(query, record.Id, p.ID, record.Trigger, record.Status, startedAt, finishedAt,
+		len(record.Plan.GetOrders()), record.Plan.GetTurnover(), record.Error, recordJSON)
+	return err
+}
+
+// transitionRebalanceRecord replaces a stored run only while it still has the from status, and
+// reports whether it did. Decisions on a proposal go through it so that only one of them wins.
+func (p *Portfolio) transitionRebalanceRecord(record *pb.RebalanceRecord, from string) (bool, error) {
+	recordJSON, err := protojson.Marshal(record)
+	if err != nil {
+		return false, err
+	}
+	var finishedAt sql.NullTime
+	if record.FinishedAt != "" {
+		t, err := time.Parse(time.RFC3339Nano, record.FinishedAt)
+		if err != nil {
+			return false, err
+		}
+		finishedAt = nullTime(t)
+	}
+
+	query := `UPDATE rebalance_runs
+              SET status = $3, finished_at = $4, error = $5, record = $6
+              WHERE id = $1 AND status = $2 AND portfolio_id = $7`
+	result, err := p.DB.Exec(query, record.Id, from, record.Status, finishedAt, record.Error, recordJSON, p.ID)
+	if err != nil {
+		return false, err
+	}
+	updated, err := result.RowsAffected()
+	return updated == 1, err
+}
+
+// loadRebalanceRecordsByStatus returns every stored run of the portfolio with the status, oldest
+// first.
+func (p *Portfolio) loadRebalanceRecordsByStatus(status string) ([]*pb.RebalanceRecord, error) {
+	rows, err := p.DB.Query(`SELECT record FROM rebalance_runs WHERE portfolio_id = $1 AND status = $2 ORDER BY started_at`,
+		p.ID, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []*pb.RebalanceRecord
+	for rows.Next() {
+		var recordJSON []byte
+		if err := rows.Scan(&recordJSON); err != nil {
+			return nil, err
+		}
+		record := &pb.RebalanceRecord{}
+		if err := protojson.Unmarshal(recordJSON, record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// loadTradedValueSince returns the value filled by the portfolio's runs that finished since the
+// time, whether they succeeded or failed part way.
+func (p *Portfolio) loadTradedValueSince(since time.Time) (float64, error) {
+	rows, err := p.DB.Query(`SELECT record FROM rebalance_runs
+              WHERE portfolio_id = $1 AND finished_at >= $2 AND status IN ($3, $4)`,
+		p.ID, since, rebalanceSucceeded, rebalanceFailed)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	traded := 0.0
+	for rows.Next() {
+		var recordJSON []byte
+		if err := rows.Scan(&recordJSON); err != nil {
+			return 0, err
+		}
+		record := &pb.RebalanceRecord{}
+		if err := protojson.Unmarshal(recordJSON, record); err != nil {
+			return 0, err
+		}
+		for _, phase := range record.Phases {
+			for _, fill := range phase.Fills {
+				traded += math.Abs(float64(fill.FilledQuantity) * fill.AveragePrice)
+			}
+		}
+	}
+	return traded, rows.Err()
+}
+
+// loadRebalanceRecord returns a stored rebalance run of the portfolio, or nil when it has none
+// with the id.
+func (p *Portfolio) loadRebalanceRecord(id string) (*pb.RebalanceRecord, error) {
+	var recordJSON []byte
+	err := p.DB.QueryRow(`SELECT record FROM rebalance_runs WHERE id = $1 AND portfolio_id = $2`, id, p.ID).Scan(&recordJSON)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := &pb.RebalanceRecord{}
+	if err := protojson.Unmarshal(recordJSON, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// listRebalanceSummaries returns the portfolio's newest runs matching the filters, which are
+// ignored when empty.
+func (p *Portfolio) listRebalanceSummaries(limit int, status, trigger string, before time.Time) ([]*pb.RebalanceSummary, error) {
+	query := `SELECT id, trigger, status, started_at, finished_at, order_count, turnover, error
+              FROM rebalance_runs
+              WHERE portfolio_id = $1
+                AND ($2 = '' OR status = $2)
+                AND ($3 = '' OR trigger = $3)
+                AND ($4::TIMESTAMPTZ IS NULL OR started_at < $4)
+              ORDER BY started_at DESC
+              LIMIT $5`
+	rows, err := p.DB.Query(query, p.ID, status, trigger, nullTime(before), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []*pb.RebalanceSummary
+	for rows.Next() {
+		var summary pb.RebalanceSummary
+		var startedAt time.Time
+		var finishedAt sql.NullTime
+		var runError sql.NullString
+		if err := rows.Scan(&summary.Id, &summary.Trigger, &summary.Status, &startedAt, &finishedAt,
+			&summary.OrderCount, &summary.Turnover, &runError); err != nil {
+			return nil, err
+		}
+		summary.StartedAt = startedAt.UTC().Format(time.RFC3339)
+		if finishedAt.Valid {
+			summary.FinishedAt = finishedAt.Time.UTC().Format(time.RFC3339)
+		}
+		summary.Error = runError.String
+		summaries = append(summaries, &summary)
+	}
+	return summaries, rows.Err()
+}
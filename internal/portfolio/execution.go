@@ -0,0 +1,210 @@
+// internal/portfolio/execution.go
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/portfolio_service"
+	tradepb "momentum-trading-platform/api/proto/trade_execution_service"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	phaseSells   = "sells"
+	phaseBuys    = "buys"
+	phaseSkipped = "SKIPPED"
+
+	executionPollInterval = time.Second
+	executionWaitTimeout  = 2 * time.Minute
+)
+
+// executePlan submits the plan's sells, waits for them to fill and then submits its buys, scaled
+// down when the cash the sells actually left does not cover them. Orders are already netted to
+// one per symbol. The phases are returned even when one fails.
+func (p *Portfolio) executePlan(ctx context.Context, plan *rebalancePlan) ([]*pb.ExecutionPhase, error) {
+	var sells, buys []*pb.Order
+	for _, order := range plan.orders {
+		if order.Quantity < 0 {
+			sells = append(sells, order)
+		} else {
+			buys = append(buys, order)
+		}
+	}
+
+	sellPhase, err := p.runPhase(ctx, phaseSells, sells, plan.current.cash)
+	phases := []*pb.ExecutionPhase{sellPhase}
+	if err != nil {
+		return phases, err
+	}
+
+	config := p.configuration()
+	reserve := config.RiskRules.GetMinCashBuffer().GetLimit() * plan.current.totalValue()
+	sizedBuys, sizing := sizeBuys(buys, sellPhase.CashAfter-reserve, config.CostBps)
+	buyPhase, err := p.runPhase(ctx, phaseBuys, sizedBuys, sellPhase.CashAfter)
+	if sizing != "" {
+		buyPhase.Message = joinMessages(sizing, buyPhase.Message)
+	}
+	phases = append(phases, buyPhase)
+	return phases, err
+}
+
+// sizeBuys scales the buys down proportionally when they cost more than budget, including
+// estimated costs, and describes any scaling.
+func sizeBuys(buys []*pb.Order, budget, costBps float64) ([]*pb.Order, string) {
+	cost := 0.0
+	for _, order := range buys {
+		cost += float64(order.Quantity) * order.Price
+	}
+	cost *= 1 + costBps/10000
+	if len(buys) == 0 || cost <= budget {
+		return buys, ""
+	}
+	if budget <= 0 {
+		return nil, "no cash available for buys"
+	}
+
+	scale := budget / cost
+	var sized []*pb.Order
+	for _, order := range buys {
+		if quantity := int32(float64(order.Quantity) * scale); quantity > 0 {
+			sized = append(sized, &pb.Order{
+				Symbol:   order.Symbol,
+				Type:     order.Type,
+				Quantity: quantity,
+				Price:    order.Price,
+			})
+		}
+	}
+	return sized, fmt.Sprintf("buys scaled to %.1f%% of the plan to fit %.2f of available cash", scale*100, budget)
+}
+
+// runPhase submits one phase of orders, waits for the execution to settle and reads the cash it
+// left.
+func (p *Portfolio) runPhase(ctx context.Context, name string, orders []*pb.Order, cashBefore float64) (*pb.ExecutionPhase, error) {
+	phase := &pb.ExecutionPhase{
+		Name:       name,
+		Orders:     orders,
+		CashBefore: cashBefore,
+		CashAfter:  cashBefore,
+	}
+	if len(orders) == 0 {
+		phase.Status = phaseSkipped
+		return phase, nil
+	}
+
+	p.Logger.WithFields(log.Fields{
+		"phase":      name,
+		"orderCount": len(orders),
+	}).Info("Submitting orders to Trade Execution Service")
+	executionResp, err := p.Clients.TradeExecutionClient.ExecuteTrades(ctx, &tradepb.ExecuteTradesRequest{
+		Orders:      toTradeOrders(orders),
+		PortfolioId: p.ID,
+	})
+	if err != nil {
+		phase.Status = tradepb.ExecutionStatusType_FAILED.String()
+		phase.Message = err.Error()
+		return phase, fmt.Errorf("failed to submit %s: %w", name, err)
+	}
+	phase.ExecutionId = executionResp.ExecutionId
+
+	execution, settled := p.awaitExecution(ctx, executionResp.ExecutionId)
+	results := executionResp.Results
+	if execution != nil {
+		results = execution.Results
+	}
+	phase.Fills = toFills(orders, results)
+	phase.Status = phaseStatus(execution, phase.Fills)
+	if !settled {
+		phase.Message = fmt.Sprintf("fills did not settle within %s", executionWaitTimeout)
+	}
+
+	account, err := p.getCurrentPortfolioState(ctx)
+	if err != nil {
+		return phase, fmt.Errorf("failed to read cash after %s: %w", name, err)
+	}
+	phase.CashAfter = account.cash
+
+	p.Logger.WithFields(log.Fields{
+		"phase":       name,
+		"executionId": phase.ExecutionId,
+		"status":      phase.Status,
+		"cashAfter":   phase.CashAfter,
+	}).Info("Execution phase finished")
+	if phase.Status == tradepb.ExecutionStatusType_FAILED.String() {
+		return phase, fmt.Errorf("%s execution %s failed", name, phase.ExecutionId)
+	}
+	return phase, nil
+}
+
+// awaitExecution polls an execution until it completes or fails, and reports whether it did
+// before the wait timed out. The last status seen is returned either way, or nil when none could
+// be read.
+func (p *Portfolio) awaitExecution(ctx context.Context, executionID string) (*tradepb.ExecutionStatus, bool) {
+	deadline := time.Now().Add(executionWaitTimeout)
+	var last *tradepb.ExecutionStatus
+	for {
+		execution, err := p.Clients.TradeExecutionClient.GetExecutionStatus(ctx, &tradepb.GetExecutionStatusRequest{
+			ExecutionId: executionID,
+		})
+		if err != nil {
+			p.Logger.WithError(err).WithField("executionId", executionID).Warn("❗ Failed to get execution status")
+		} else {
+			last = execution
+			if execution.Status == tradepb.ExecutionStatusType_COMPLETED || execution.Status == tradepb.ExecutionStatusType_FAILED {
+				return last, true
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return last, false
+		}
+		select {
+		case <-ctx.Done():
+			return last, false
+		case <-time.After(executionPollInterval):
+		}
+	}
+}
+
+// phaseStatus is the execution status, or PARTIAL for a completed execution with partial fills.
+func phaseStatus(execution *tradepb.ExecutionStatus, fills []*pb.OrderFill) string {
+	if execution == nil {
+		return tradepb.ExecutionStatusType_PENDING.String()
+	}
+	if execution.Status == tradepb.ExecutionStatusType_COMPLETED {
+		for _, fill := range fills {
+			if fill.FilledQuantity != fill.RequestedQuantity {
+				return tradepb.ExecutionStatusType_PARTIAL.String()
+			}
+		}
+	}
+	return execution.Status.String()
+}
+
+func toFills(orders []*pb.Order, results []*tradepb.OrderExecutionResult) []*pb.OrderFill {
+	requested := make(map[string]int32, len(orders))
+	for _, order := range orders {
+		requested[order.Symbol] = order.Quantity
+	}
+	fills := make([]*pb.OrderFill, len(results))
+	for i, result := range results {
+		fills[i] = &pb.OrderFill{
+			Symbol:            result.Symbol,
+			RequestedQuantity: requested[result.Symbol],
+			FilledQuantity:    result.FilledQuantity,
+			AveragePrice:      result.AveragePrice,
+			Status:            result.Status.String(),
+		}
+	}
+	return fills
+}
+
+func joinMessages(a, b string) string {
+	if a == "" || b == "" {
+		return a + b
+	}
+	return a + "; " + b
+}
@@ -0,0 +1,109 @@
+// internal/portfolio/history.go
+package portfolio
+
+import (
+	"context"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/portfolio_service"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	triggerScheduled = "scheduled"
+	triggerManual    = "manual"
+
+	defaultRebalanceListLimit = 20
+	maxRebalanceListLimit     = 200
+	resultingStateTimeout     = 10 * time.Second
+)
+
+// finishRebalance completes a run's record with its outcome and the resulting portfolio state,
+// stores it and records the outcome on the schedule. A proposal awaiting approval is stored
+// without finishing. The state is read with its own timeout so that a run cut short by its
+// context still records what it left behind.
+func (p *Portfolio) finishRebalance(ctx context.Context, record *pb.RebalanceRecord, err error) {
+	finishedAt := time.Now()
+	switch {
+	case err != nil:
+		record.Status = rebalanceFailed
+		record.Error = err.Error()
+	case record.Status != rebalancePendingApproval:
+		record.Status = rebalanceSucceeded
+	}
+	if record.Status != rebalancePendingApproval {
+		record.FinishedAt = finishedAt.UTC().Format(time.RFC3339)
+	}
+
+	stateCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), resultingStateTimeout)
+	defer cancel()
+	if account, stateErr := p.getCurrentPortfolioState(stateCtx); stateErr != nil {
+		p.Logger.WithError(stateErr).Warn("❗ Failed to read the portfolio state after the rebalance")
+	} else {
+		record.ResultingState = account.portfolioState()
+	}
+
+	if storeErr := p.storeRebalanceRecord(record); storeErr != nil {
+		p.Logger.WithError(storeErr).WithField("rebalanceId", record.Id).Error("❌ Failed to store rebalance run")
+	} else {
+		p.Logger.WithFields(log.Fields{
+			"rebalanceId": record.Id,
+			"status":      record.Status,
+		}).Info("🗄️ Stored rebalance run")
+	}
+	p.recordRebalance(finishedAt, record.Status, record.Error)
+}
+
+// portfolioState converts the account to the service's portfolio state, positions in symbol order.
+func (a *accountState) portfolioState() *pb.PortfolioState {
+	symbols := unionSymbols(a.positions, nil)
+	positions := make([]*pb.Position, len(symbols))
+	for i, symbol := range symbols {
+		positions[i] = a.positions[symbol]
+	}
+	return &pb.PortfolioState{
+		Positions:   positions,
+		CashBalance: a.cash,
+		TotalValue:  a.totalValue(),
+	}
+}
+
+func (p *Portfolio) ListRebalances(ctx context.Context, req *pb.ListRebalancesRequest) (*pb.ListRebalancesResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultRebalanceListLimit
+	}
+	limit = min(limit, maxRebalanceListLimit)
+
+	var before time.Time
+	if req.Before != "" {
+		var err error
+		if before, err = time.Parse(time.RFC3339, req.Before); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid before time %q, expected RFC 3339", req.Before)
+		}
+	}
+
+	p.expireProposals("")
+	summaries, err := p.listRebalanceSummaries(limit, req.Status, req.Trigger, before)
+	if err != nil {
+		p.Logger.WithError(err).Error("❌ Failed to list rebalance runs")
+		return nil, status.Errorf(codes.Internal, "failed to list rebalance runs: %v", err)
+	}
+	return &pb.ListRebalancesResponse{Rebalances: summaries}, nil
+}
+
+func (p *Portfolio) GetRebalance(ctx context.Context, req *pb.GetRebalanceRequest) (*pb.RebalanceRecord, error) {
+	p.expireProposals("")
+	record, err := p.loadRebalanceRecord(req.Id)
+	if err != nil {
+		p.Logger.WithError(err).Error("❌ Failed to load rebalance run")
+		return nil, status.Errorf(codes.Internal, "failed to load rebalance run: %v", err)
+	}
+	if record == nil {
+		return nil, status.Errorf(codes.NotFound, "rebalance run %s not found", req.Id)
+	}
+	return record, nil
+}
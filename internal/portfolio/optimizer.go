@@ -0,0 +1,406 @@
+// internal/portfolio/optimizer.go
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+	pb "momentum-trading-platform/api/proto/portfolio_service"
+	strategypb "momentum-trading-platform/api/proto/strategy_service"
+	"momentum-trading-platform/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	optimizerRiskUnits         = "riskUnits"
+	optimizerEqualWeight       = "equalWeight"
+	optimizerInverseVolatility = "inverseVolatility"
+	optimizerRiskParity        = "riskParity"
+	optimizerMinVariance       = "minVariance"
+	optimizerMeanVariance      = "meanVariance"
+
+	defaultCovarianceDays = 126 // Six months of trading days
+	maxCovarianceDays     = 1260
+	minCovarianceReturns  = 20
+	defaultRiskAversion   = 10
+	tradingDaysPerYear    = 252
+
+	// expectedReturnSpread is the annual expected return meanVariance assigns the best-ranked signal
+	// over the worst-ranked one. Ranking keeps risk_aversion on the same scale for every strategy,
+	// whatever the scale of its momentum scores.
+	expectedReturnSpread = 0.1
+
+	maxOptimizerIterations = 5000
+	optimizerTolerance     = 1e-10
+)
+
+// normalizeOptimizer fills in the optimizer defaults and checks the settings. Without settings the
+// strategy's risk units set the weights.
+func normalizeOptimizer(settings *pb.OptimizerSettings) error {
+	if settings == nil {
+		return nil
+	}
+	switch settings.Method {
+	case "":
+		settings.Method = optimizerRiskUnits
+	case optimizerRiskUnits, optimizerEqualWeight, optimizerInverseVolatility, optimizerRiskParity, optimizerMinVariance, optimizerMeanVariance:
+	default:
+		return fmt.Errorf("unknown optimizer method %q", settings.Method)
+	}
+	if settings.CovarianceDays == 0 {
+		settings.CovarianceDays = defaultCovarianceDays
+	}
+	if settings.CovarianceDays < minCovarianceReturns || settings.CovarianceDays > maxCovarianceDays {
+		return fmt.Errorf("covariance_days must be between %d and %d", minCovarianceReturns, maxCovarianceDays)
+	}
+	if settings.MaxWeight < 0 || settings.MaxWeight > 1 {
+		return fmt.Errorf("optimizer max_weight must be between 0 and 1")
+	}
+	if settings.RiskAversion == 0 {
+		settings.RiskAversion = defaultRiskAversion
+	}
+	if settings.RiskAversion < 0 {
+		return fmt.Errorf("risk_aversion must not be negative")
+	}
+	return nil
+}
+
+// targetWeights returns the weight of each signal's symbol, summing to at most one and each at
+// most the optimizer's max_weight when it is set. Methods that need a covariance matrix leave out
+// symbols without enough history.
+func (p *Portfolio) targetWeights(ctx context.Context, settings *pb.OptimizerSettings, signals []*strategypb.StockSignal) (map[string]float64, error) {
+	switch settings.GetMethod() {
+	case optimizerMinVariance, optimizerMeanVariance:
+		// The cap is a constraint of the optimization itself
+		return p.optimizedWeights(ctx, settings, signals)
+	}
+	weights, err := p.optimizedWeights(ctx, settings, signals)
+	if err != nil {
+		return nil, err
+	}
+	return capWeights(weights, settings.GetMaxWeight()), nil
+}
+
+// optimizedWeights returns the weights the optimizer method sets.
+func (p *Portfolio) optimizedWeights(ctx context.Context, settings *pb.OptimizerSettings, signals []*strategypb.StockSignal) (map[string]float64, error) {
+	method := settings.GetMethod()
+	switch method {
+	case "", optimizerRiskUnits:
+		return riskUnitWeights(signals), nil
+	case optimizerEqualWeight:
+		weights := make(map[string]float64, len(signals))
+		for _, signal := range signals {
+			weights[signal.Symbol] = 1 / float64(len(signals))
+		}
+		return weights, nil
+	}
+	if len(signals) == 0 {
+		return map[string]float64{}, nil
+	}
+
+	symbols := make([]string, len(signals))
+	for i, signal := range signals {
+		symbols[i] = signal.Symbol
+	}
+	symbols, returns, err := p.fetchReturns(ctx, symbols, int(settings.CovarianceDays))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch returns for the %s optimizer: %w", method, err)
+	}
+	cov := utils.CovarianceMatrix(returns)
+	for i := range cov {
+		for j := range cov[i] {
+			cov[i][j] *= tradingDaysPerYear
+		}
+	}
+
+	var optimized []float64
+	switch method {
+	case optimizerInverseVolatility:
+		optimized = inverseVolatilityWeights(cov)
+	case optimizerRiskParity:
+		optimized = utils.RiskParityWeights(cov)
+	case optimizerMinVariance:
+		optimized = meanVarianceWeights(cov, nil, 1, settings.MaxWeight)
+	case optimizerMeanVariance:
+		scores := make(map[string]float64, len(signals))
+		for _, signal := range signals {
+			scores[signal.Symbol] = signal.MomentumScore
+		}
+		expected := make([]float64, len(symbols))
+		for i, symbol := range symbols {
+			expected[i] = scores[symbol]
+		}
+		for i, rank := range percentileRanks(expected) {
+			expected[i] = rank * expectedReturnSpread
+		}
+		optimized = meanVarianceWeights(cov, expected, settings.RiskAversion, settings.MaxWeight)
+	}
+
+	weights := make(map[string]float64, len(symbols))
+	for i, symbol := range symbols {
+		weights[symbol] = optimized[i]
+	}
+	p.Logger.WithFields(log.Fields{
+		"method":  method,
+		"symbols": len(symbols),
+		"days":    len(returns[0]),
+	}).Info("Optimized target weights")
+	return weights, nil
+}
+
+func riskUnitWeights(signals []*strategypb.StockSignal) map[string]float64 {
+	weights := make(map[string]float64, len(signals))
+	total := 0.0
+	for _, signal := range signals {
+		total += signal.RiskUnit
+	}
+	if total <= 0 {
+		return weights
+	}
+	for _, signal := range signals {
+		weights[signal.Symbol] = signal.RiskUnit / total
+	}
+	return weights
+}
+
+// capWeights limits each weight to maxWeight, when it is positive, moving the excess to the other
+// positions as far as their caps allow and leaving the rest in cash.
+func capWeights(weights map[string]float64, maxWeight float64) map[string]float64 {
+	total := 0.0
+	for _, weight := range weights {
+		total += weight
+	}
+	if maxWeight <= 0 || total <= 0 {
+		return weights
+	}
+
+	symbols := make([]string, 0, len(weights))
+	for symbol := range weights {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	values := make([]float64, len(symbols))
+	for i, symbol := range symbols {
+		values[i] = weights[symbol]
+	}
+	capped := make(map[string]float64, len(symbols))
+	for i, weight := range projectCappedSimplex(values, maxWeight) {
+		capped[symbols[i]] = weight
+	}
+	return capped
+}
+
+// percentileRanks returns the rank of each value scaled to [0, 1], from the lowest to the highest.
+// Equal values share their average rank, and a single value ranks 0.
+func percentileRanks(values []float64) []float64 {
+	ranks := make([]float64, len(values))
+	if len(values) < 2 {
+		return ranks
+	}
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return values[order[a]] < values[order[b]] })
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && values[order[end]] == values[order[start]] {
+			end++
+		}
+		rank := float64(start+end-1) / 2 / float64(len(values)-1)
+		for _, i := range order[start:end] {
+			ranks[i] = rank
+		}
+		start = end
+	}
+	return ranks
+}
+
+// fetchReturns returns aligned daily log returns of adjusted closes over the last days trading
+// days, on the dates every symbol has a price. Symbols with too little history are dropped, so the
+// returned symbols may be fewer than requested.
+func (p *Portfolio) fetchReturns(ctx context.Context, symbols []string, days int) ([]string, [][]float64, error) {
+	// Calendar days that cover the trading days, with room for holidays
+	end := time.Now()
+	start := end.AddDate(0, 0, -(days*7/5 + 14))
+	resp, err := p.Clients.DataClient.GetBatchStockData(ctx, &datapb.BatchStockRequest{
+		Symbols:   symbols,
+		StartDate: start.Format("2006-01-02"),
+		EndDate:   end.Format("2006-01-02"),
+		Interval:  "1d",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var available []string
+	closes := make(map[string]map[int64]float64, len(symbols))
+	for _, symbol := range symbols {
+		data, ok := resp.StockData[symbol]
+		if !ok || len(data.DataPoints) <= minCovarianceReturns {
+			p.Logger.WithField("symbol", symbol).Warn("❗ Not enough price history to optimize, leaving the symbol out")
+			continue
+		}
+		prices := make(map[int64]float64, len(data.DataPoints))
+		for _, dp := range data.DataPoints {
+			if dp.AdjustedClose > 0 {
+				prices[dp.Timestamp] = dp.AdjustedClose
+			}
+		}
+		closes[symbol] = prices
+		available = append(available, symbol)
+	}
+	if len(available) == 0 {
+		return nil, nil, fmt.Errorf("no symbol has enough price history")
+	}
+
+	var timestamps []int64
+	for timestamp := range closes[available[0]] {
+		shared := true
+		for _, symbol := range available[1:] {
+			if _, ok := closes[symbol][timestamp]; !ok {
+				shared = false
+				break
+			}
+		}
+		if shared {
+			timestamps = append(timestamps, timestamp)
+		}
+	}
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+	if len(timestamps) > days+1 {
+		timestamps = timestamps[len(timestamps)-days-1:]
+	}
+	if len(timestamps) <= minCovarianceReturns {
+		return nil, nil, fmt.Errorf("only %d shared trading days across %d symbols", len(timestamps), len(available))
+	}
+
+	returns := make([][]float64, len(available))
+	for i, symbol := range available {
+		returns[i] = make([]float64, len(timestamps)-1)
+		for t := 1; t < len(timestamps); t++ {
+			returns[i][t-1] = math.Log(closes[symbol][timestamps[t]] / closes[symbol][timestamps[t-1]])
+		}
+	}
+	return available, returns, nil
+}
+
+// inverseVolatilityWeights weights each asset by the inverse of its volatility. Assets with zero
+// variance get no weight.
+func inverseVolatilityWeights(cov [][]float64) []float64 {
+	weights := make([]float64, len(cov))
+	total := 0.0
+	for i := range cov {
+		if cov[i][i] > 0 {
+			weights[i] = 1 / math.Sqrt(cov[i][i])
+			total += weights[i]
+		}
+	}
+	if total > 0 {
+		for i := range weights {
+			weights[i] /= total
+		}
+	}
+	return weights
+}
+
+// meanVarianceWeights maximizes expected'w - riskAversion/2 w'Σw over long-only weights that sum
+// to one, each at most maxWeight when it is positive. Without expected returns this is the
+// minimum variance portfolio. It uses projected gradient descent with a step of one over the
+// largest eigenvalue of the scaled covariance, which converges for this convex problem.
+func meanVarianceWeights(cov [][]float64, expected []float64, riskAversion, maxWeight float64) []float64 {
+	n := len(cov)
+	upper := 1.0
+	if maxWeight > 0 {
+		upper = maxWeight
+	}
+	if float64(n)*upper < 1 {
+		// The cap cannot be met fully invested, so every asset takes the cap and the rest is cash
+		weights := make([]float64, n)
+		for i := range weights {
+			weights[i] = upper
+		}
+		return weights
+	}
+
+	sigma := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			sigma.SetSym(i, j, cov[i][j])
+		}
+	}
+	var eigen mat.EigenSym
+	if !eigen.Factorize(sigma, false) {
+		return projectCappedSimplex(make([]float64, n), upper)
+	}
+	values := eigen.Values(nil)
+	lipschitz := riskAversion * values[len(values)-1]
+	if lipschitz <= 0 {
+		return projectCappedSimplex(make([]float64, n), upper)
+	}
+	step := 1 / lipschitz
+
+	mu := mat.NewVecDense(n, nil)
+	if expected != nil {
+		mu = mat.NewVecDense(n, append([]float64(nil), expected...))
+	}
+	weights := projectCappedSimplex(make([]float64, n), upper)
+	var gradient mat.VecDense
+	for iter := 0; iter < maxOptimizerIterations; iter++ {
+		w := mat.NewVecDense(n, weights)
+		gradient.MulVec(sigma, w)
+		gradient.ScaleVec(riskAversion, &gradient)
+		gradient.SubVec(&gradient, mu)
+
+		candidate := make([]float64, n)
+		for i := range candidate {
+			candidate[i] = weights[i] - step*gradient.AtVec(i)
+		}
+		updated := projectCappedSimplex(candidate, upper)
+
+		change := 0.0
+		for i := range updated {
+			change = math.Max(change, math.Abs(updated[i]-weights[i]))
+		}
+		weights = updated
+		if change < optimizerTolerance {
+			break
+		}
+	}
+	return weights
+}
+
+// projectCappedSimplex returns the closest point to v whose entries lie in [0, upper] and sum to
+// one, found by bisection on the shift tau in w_i = clamp(v_i - tau, 0, upper).
+func projectCappedSimplex(v []float64, upper float64) []float64 {
+	clamped := func(tau float64) ([]float64, float64) {
+		w := make([]float64, len(v))
+		total := 0.0
+		for i, x := range v {
+			w[i] = math.Min(math.Max(x-tau, 0), upper)
+			total += w[i]
+		}
+		return w, total
+	}
+
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, x := range v {
+		low, high = math.Min(low, x-upper), math.Max(high, x)
+	}
+	for iter := 0; iter < 100; iter++ {
+		tau := (low + high) / 2
+		if _, total := clamped(tau); total > 1 {
+			low = tau
+		} else {
+			high = tau
+		}
+	}
+	w, _ := clamped((low + high) / 2)
+	return w
+}
@@ -0,0 +1,253 @@
+// internal/portfolio/portfolios.go
+package portfolio
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/portfolio_service"
+	"momentum-trading-platform/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// portfolio returns the portfolio a request names, the default portfolio when it names none.
+func (s *Server) portfolio(id string) (*Portfolio, error) {
+	id, err := utils.PortfolioID(id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.portfoliosMu.RLock()
+	defer s.portfoliosMu.RUnlock()
+	p, ok := s.portfolios[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "portfolio %s not found", id)
+	}
+	return p, nil
+}
+
+// StartScheduler runs the scheduled rebalances of every portfolio, including those created
+// later, in the background until ctx is cancelled. Portfolios without a schedule wait until one
+// is set.
+func (s *Server) StartScheduler(ctx context.Context) {
+	s.portfoliosMu.Lock()
+	defer s.portfoliosMu.Unlock()
+
+	s.schedulerCtx = ctx
+	for _, p := range s.portfolios {
+		p.startScheduler(ctx)
+	}
+}
+
+// CreatePortfolio adds a portfolio with its own account, configuration and schedule. The
+// configuration and the schedule are validated and the account is read before anything is stored,
+// and a portfolio that fails to store is removed again, so that a failed call leaves nothing to be
+// loaded on restart. The id is reserved for the duration of the call; lookups of other portfolios
+// are not held up while it runs.
+func (s *Server) CreatePortfolio(ctx context.Context, req *pb.CreatePortfolioRequest) (*pb.CreatePortfolioResponse, error) {
+	if req.PortfolioId == "" {
+		return nil, status.Error(codes.InvalidArgument, "portfolio id is required")
+	}
+	id, err := utils.PortfolioID(req.PortfolioId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var schedule Schedule
+	if strings.TrimSpace(req.Schedule) != "" {
+		if schedule, err = ParseSchedule(req.Schedule); err != nil {
+			return &pb.CreatePortfolioResponse{
+				Success: false,
+				Message: "Invalid rebalance schedule: " + err.Error(),
+			}, nil
+		}
+	}
+
+	if err := s.reservePortfolioID(id); err != nil {
+		return nil, err
+	}
+	defer s.releasePortfolioID(id)
+
+	p := s.newPortfolio(id)
+	config, err := p.checkConfiguration(ctx, req.Configuration)
+	if err != nil {
+		return &pb.CreatePortfolioResponse{
+			Success: false,
+			Message: "Invalid portfolio configuration: " + err.Error(),
+		}, nil
+	}
+	p.Configuration = config
+	if schedule != nil {
+		p.RebalanceSchedule = schedule
+		p.scheduleState = scheduleState{schedule: schedule.String(), nextRun: schedule.Next(time.Now())}
+	}
+	if err := p.loadState(); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to read the account of portfolio %s: %v", id, err)
+	}
+
+	if err := p.storeNewPortfolio(); err != nil {
+		p.Logger.WithError(err).Error("❌ Failed to store new portfolio")
+		if deleteErr := p.deletePortfolio(); deleteErr != nil {
+			p.Logger.WithError(deleteErr).Error("❌ Failed to remove partially stored portfolio")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to store portfolio %s: %v", id, err)
+	}
+
+	// The scheduler owns the schedule state once it runs
+	nextRun := p.scheduleState.nextRun
+	s.portfoliosMu.Lock()
+	s.portfolios[id] = p
+	if s.schedulerCtx != nil {
+		p.startScheduler(s.schedulerCtx)
+	}
+	s.portfoliosMu.Unlock()
+
+	p.Logger.WithFields(log.Fields{
+		"strategy": config.StrategyName,
+		"nextRun":  nextRun,
+	}).Info("🆕 Portfolio created")
+
+	return &pb.CreatePortfolioResponse{
+		Success:       true,
+		Message:       "Portfolio created successfully",
+		Configuration: proto.Clone(config).(*pb.PortfolioConfiguration),
+		NextRun:       formatScheduleTime(nextRun),
+	}, nil
+}
+
+// reservePortfolioID claims an id for a portfolio being created, failing when a portfolio with
+// the id exists or is being created.
+func (s *Server) reservePortfolioID(id string) error {
+	s.portfoliosMu.Lock()
+	defer s.portfoliosMu.Unlock()
+	if _, exists := s.portfolios[id]; exists || s.creating[id] {
+		return status.Errorf(codes.AlreadyExists, "portfolio %s already exists", id)
+	}
+	s.creating[id] = true
+	return nil
+}
+
+func (s *Server) releasePortfolioID(id string) {
+	s.portfoliosMu.Lock()
+	defer s.portfoliosMu.Unlock()
+	delete(s.creating, id)
+}
+
+func (s *Server) ListPortfolios(ctx context.Context, req *pb.ListPortfoliosRequest) (*pb.ListPortfoliosResponse, error) {
+	s.portfoliosMu.RLock()
+	portfolios := make([]*Portfolio, 0, len(s.portfolios))
+	for _, p := range s.portfolios {
+		portfolios = append(portfolios, p)
+	}
+	s.portfoliosMu.RUnlock()
+	sort.Slice(portfolios, func(i, j int) bool { return portfolios[i].ID < portfolios[j].ID })
+
+	summaries := make([]*pb.PortfolioSummary, len(portfolios))
+	for i, p := range portfolios {
+		p.settingsMu.Lock()
+		summaries[i] = &pb.PortfolioSummary{
+			PortfolioId:      p.ID,
+			StrategyName:     p.Configuration.StrategyName,
+			UniverseSize:     int32(len(p.Configuration.Universe)),
+			ApprovalRequired: p.Configuration.ApprovalRequired,
+			Schedule:         p.scheduleState.schedule,
+			NextRun:          formatScheduleTime(p.scheduleState.nextRun),
+			LastStatus:       p.scheduleState.lastStatus,
+		}
+		p.settingsMu.Unlock()
+	}
+	return &pb.ListPortfoliosResponse{Portfolios: summaries}, nil
+}
+
+func (s *Server) GetDesiredPortfolioState(ctx context.Context, req *pb.GetDesiredPortfolioStateRequest) (*pb.PortfolioState, error) {
+	p, err := s.portfolio(req.PortfolioId)
+	if err != nil {
+		return nil, err
+	}
+	return p.GetDesiredPortfolioState(ctx, req)
+}
+
+func (s *Server) TriggerRebalance(ctx context.Context, req *pb.TriggerRebalanceRequest) (*pb.TriggerRebalanceResponse, error) {
+	p, err := s.portfolio(req.PortfolioId)
+	if err != nil {
+		return nil, err
+	}
+	return p.TriggerRebalance(ctx, req)
+}
+
+func (s *Server) PreviewRebalance(ctx context.Context, req *pb.PreviewRebalanceRequest) (*pb.RebalancePreview, error) {
+	p, err := s.portfolio(req.PortfolioId)
+	if err != nil {
+		return nil, err
+	}
+	return p.PreviewRebalance(ctx, req)
+}
+
+func (s *Server) ListRebalances(ctx context.Context, req *pb.ListRebalancesRequest) (*pb.ListRebalancesResponse, error) {
+	p, err := s.portfolio(req.PortfolioId)
+	if err != nil {
+		return nil, err
+	}
+	return p.ListRebalances(ctx, req)
+}
+
+func (s *Server) GetRebalance(ctx context.Context, req *pb.GetRebalanceRequest) (*pb.RebalanceRecord, error) {
+	p, err := s.portfolio(req.PortfolioId)
+	if err != nil {
+		return nil, err
+	}
+	return p.GetRebalance(ctx, req)
+}
+
+func (s *Server) ApproveRebalance(ctx context.Context, req *pb.ApproveRebalanceRequest) (*pb.ApproveRebalanceResponse, error) {
+	p, err := s.portfolio(req.PortfolioId)
+	if err != nil {
+		return nil, err
+	}
+	return p.ApproveRebalance(ctx, req)
+}
+
+func (s *Server) RejectRebalance(ctx context.Context, req *pb.RejectRebalanceRequest) (*pb.RejectRebalanceResponse, error) {
+	p, err := s.portfolio(req.PortfolioId)
+	if err != nil {
+		return nil, err
+	}
+	return p.RejectRebalance(ctx, req)
+}
+
+func (s *Server) UpdateRebalanceSchedule(ctx context.Context, req *pb.UpdateRebalanceScheduleRequest) (*pb.UpdateRebalanceScheduleResponse, error) {
+	p, err := s.portfolio(req.PortfolioId)
+	if err != nil {
+		return nil, err
+	}
+	return p.UpdateRebalanceSchedule(ctx, req)
+}
+
+func (s *Server) GetRebalanceSchedule(ctx context.Context, req *pb.GetRebalanceScheduleRequest) (*pb.RebalanceSchedule, error) {
+	p, err := s.portfolio(req.PortfolioId)
+	if err != nil {
+		return nil, err
+	}
+	return p.GetRebalanceSchedule(ctx, req)
+}
+
+func (s *Server) ConfigurePortfolio(ctx context.Context, req *pb.ConfigurePortfolioRequest) (*pb.ConfigurePortfolioResponse, error) {
+	p, err := s.portfolio(req.PortfolioId)
+	if err != nil {
+		return nil, err
+	}
+	return p.ConfigurePortfolio(ctx, req)
+}
+
+func (s *Server) GetPortfolioConfiguration(ctx context.Context, req *pb.GetPortfolioConfigurationRequest) (*pb.PortfolioConfiguration, error) {
+	p, err := s.portfolio(req.PortfolioId)
+	if err != nil {
+		return nil, err
+	}
+	return p.GetPortfolioConfiguration(ctx, req)
+}
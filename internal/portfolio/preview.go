@@ -0,0 +1,62 @@
+// internal/portfolio/preview.go
+package portfolio
+
+import (
+	"context"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/portfolio_service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PreviewRebalance plans a rebalance on the latest signals and reports what it would trade,
+// without submitting any orders or changing the desired portfolio.
+func (p *Portfolio) PreviewRebalance(ctx context.Context, req *pb.PreviewRebalanceRequest) (*pb.RebalancePreview, error) {
+	record, err := p.rebalance(ctx, triggerManual, true)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to preview rebalance: %v", err)
+	}
+	return record.Plan, nil
+}
+
+// buildPreview compares the current and target positions of a plan and estimates its costs at
+// costBps of the traded value.
+func buildPreview(plan *rebalancePlan, costBps float64) *pb.RebalancePreview {
+	totalValue := plan.current.totalValue()
+	weight := func(quantity int32, price float64) float64 {
+		if totalValue <= 0 {
+			return 0
+		}
+		return float64(quantity) * price / totalValue
+	}
+
+	preview := &pb.RebalancePreview{
+		Orders:      plan.orders,
+		TotalValue:  totalValue,
+		CashBefore:  plan.current.cash,
+		Violations:  plan.violations,
+		Blocked:     plan.blocked,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	for _, symbol := range plan.symbols() {
+		change := &pb.PositionChange{Symbol: symbol, KeptReason: plan.kept[symbol]}
+		change.CurrentQuantity, change.TargetQuantity, change.Price = positionChange(plan.current.positions, plan.target, symbol)
+		change.CurrentWeight = weight(change.CurrentQuantity, change.Price)
+		change.TargetWeight = weight(change.TargetQuantity, change.Price)
+		change.TradeQuantity = change.TargetQuantity - change.CurrentQuantity
+		change.TradeValue = float64(change.TradeQuantity) * change.Price
+		preview.Positions = append(preview.Positions, change)
+	}
+
+	traded := tradedValue(plan.current.positions, plan.target)
+	preview.EstimatedCosts = traded * costBps / 10000
+	preview.CashAfter = plan.targetCash() - preview.EstimatedCosts
+	if totalValue > 0 {
+		preview.Turnover = traded / totalValue
+		preview.AvoidedTurnover = plan.avoided / totalValue
+	}
+	return preview
+}
@@ -7,158 +7,156 @@ import (
 	"time"
 
 	pb "momentum-trading-platform/api/proto/portfolio_service"
-	portfoliostatepb "momentum-trading-platform/api/proto/portfolio_state_service"
 	strategypb "momentum-trading-platform/api/proto/strategy_service"
-)
+	"momentum-trading-platform/internal/utils"
 
-func (s *Server) PerformRebalance(ctx context.Context) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
 
-	s.Logger.Info("Starting portfolio rebalance")
+// PerformRebalance generates orders from the latest signals and submits them, or proposes them
+// when approval is required. The run is stored with its trigger, and its outcome is recorded as
+// the last run of the rebalance schedule.
+func (p *Portfolio) PerformRebalance(ctx context.Context, trigger string) error {
+	_, err := p.rebalance(ctx, trigger, false)
+	return err
+}
 
-	// Get latest signals
-	signals, err := s.getLatestSignals(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get latest signals: %w", err)
+// rebalance plans a rebalance on the latest signals and, unless dryRun is set, executes its
+// orders and stores the run. When approval is required the orders are only proposed. The record is returned with the error, holding whatever the run
+// got to.
+func (p *Portfolio) rebalance(ctx context.Context, trigger string, dryRun bool) (record *pb.RebalanceRecord, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	config := p.configuration()
+	record = &pb.RebalanceRecord{
+		PortfolioId:   p.ID,
+		Trigger:       trigger,
+		StartedAt:     time.Now().UTC().Format(time.RFC3339),
+		Configuration: config,
 	}
-	s.Logger.WithField("signalCount", len(signals)).Info("Received signals for rebalance")
-
-	// Generate orders based on signals
-	ordersResp, err := s.GenerateAndSubmitOrders(ctx, &pb.GenerateOrdersRequest{Signals: signals})
-	if err != nil {
-		return fmt.Errorf("failed to generate and submit orders: %w", err)
+	if !dryRun {
+		record.Id = uuid.New().String()
+		defer func() { p.finishRebalance(ctx, record, err) }()
 	}
-	s.Logger.WithField("orderCount", len(ordersResp.Orders)).Info("Generated and submitted rebalance orders")
-
-	s.lastRebalanceTime = time.Now()
-	s.Logger.WithField("lastRebalanceTime", s.lastRebalanceTime).Info("Rebalance completed successfully")
-	return nil
-}
 
-func (s *Server) getLatestSignals(ctx context.Context) ([]*strategypb.StockSignal, error) {
-	s.Logger.Info("Fetching latest signals from Strategy Service")
+	p.Logger.WithFields(log.Fields{
+		"trigger": trigger,
+		"dryRun":  dryRun,
+	}).Info("Starting portfolio rebalance")
 
-	// Assuming we want signals for all stocks in S&P 500
-	symbols := []string{"AAPL", "GOOGL", "MSFT"} // This should be expanded to include all relevant symbols
-
-	req := &strategypb.SignalRequest{
-		Symbols:   symbols,
-		StartDate: time.Now().AddDate(0, 0, -7).Format("2006-01-02"), // Last 7 days
-		EndDate:   time.Now().Format("2006-01-02"),
-		Interval:  "1d",
-	}
-
-	resp, err := s.Clients.StrategyClient.GenerateSignals(ctx, req)
+	// Get latest signals
+	signalResp, err := p.getLatestSignals(ctx, config)
 	if err != nil {
-		s.Logger.WithError(err).Error("Failed to fetch signals from Strategy Service")
-		return nil, fmt.Errorf("failed to fetch signals: %w", err)
+		return record, fmt.Errorf("failed to get latest signals: %w", err)
+	}
+	record.Signals = signalResp
+	p.Logger.WithFields(log.Fields{
+		"signalCount":    len(signalResp.Signals),
+		"regime":         signalResp.MarketRegime,
+		"targetExposure": utils.TargetExposure(signalResp),
+	}).Info("Received signals for rebalance")
+
+	// Without signals every position would be sold, which only a cash regime action asks for; an
+	// empty universe or missing data would otherwise liquidate the portfolio
+	if len(signalResp.Signals) == 0 && signalResp.RegimeAction != strategypb.RegimeAction_CASH {
+		return record, fmt.Errorf("strategy %s returned no signals outside a cash regime, refusing to sell every position", config.StrategyName)
 	}
 
-	s.Logger.WithField("signalCount", len(resp.Signals)).Info("Received signals from Strategy Service")
-	return resp.Signals, nil
-}
-
-func (s *Server) generateRebalanceOrders() []*pb.Order {
-	s.Logger.Info("Generating rebalance orders")
-	var orders []*pb.Order
-
-	// Get current portfolio state from Portfolio State Service
-	currentPortfolio, err := s.getCurrentPortfolio(context.Background())
+	// Plan orders based on signals, investing only the exposure allowed by the market regime
+	plan, err := p.planOrders(ctx, signalResp)
 	if err != nil {
-		s.Logger.WithError(err).Error("Failed to get current portfolio state")
-		return orders
+		return record, fmt.Errorf("failed to plan orders: %w", err)
 	}
 
-	for symbol, desiredPosition := range s.DesiredPortfolio {
-		currentPosition, exists := currentPortfolio[symbol]
-		if !exists {
-			// New position to open
-			orders = append(orders, &pb.Order{
-				Symbol:   symbol,
-				Type:     pb.OrderType_MARKET,
-				Quantity: desiredPosition.Quantity,
-			})
-		} else if desiredPosition.Quantity != currentPosition.Quantity {
-			// Existing position to adjust
-			orderQuantity := desiredPosition.Quantity - currentPosition.Quantity
-			orderType := pb.OrderType_MARKET
-			if orderQuantity > 0 {
-				orders = append(orders, &pb.Order{
-					Symbol:   symbol,
-					Type:     orderType,
-					Quantity: orderQuantity,
-				})
-			} else {
-				orders = append(orders, &pb.Order{
-					Symbol:   symbol,
-					Type:     orderType,
-					Quantity: -orderQuantity,
-				})
-			}
-		}
+	preview := buildPreview(plan, config.CostBps)
+	preview.MarketRegime = signalResp.MarketRegime.String()
+	preview.TargetExposure = utils.TargetExposure(signalResp)
+	preview.StrategyName = config.StrategyName
+	record.Plan = preview
+
+	p.Logger.WithFields(log.Fields{
+		"orderCount":     len(preview.Orders),
+		"turnover":       preview.Turnover,
+		"estimatedCosts": preview.EstimatedCosts,
+		"cashAfter":      preview.CashAfter,
+		"violations":     len(preview.Violations),
+	}).Info("Rebalance planned")
+
+	if dryRun {
+		return record, nil
+	}
+	if plan.blocked {
+		return record, fmt.Errorf("rebalance blocked by risk rules: %s", describeViolations(plan.violations))
+	}
+	if config.ApprovalRequired && len(plan.orders) > 0 {
+		p.propose(record, config)
+		return record, nil
 	}
 
-	// Check for positions to close
-	for symbol, currentPosition := range currentPortfolio {
-		if _, exists := s.DesiredPortfolio[symbol]; !exists {
-			orders = append(orders, &pb.Order{
-				Symbol:   symbol,
-				Type:     pb.OrderType_MARKET,
-				Quantity: -currentPosition.Quantity,
-			})
-		}
+	record.Phases, err = p.submitPlan(ctx, plan)
+	if err != nil {
+		return record, fmt.Errorf("failed to submit orders: %w", err)
 	}
 
-	return orders
+	p.Logger.Info("Rebalance completed successfully")
+	return record, nil
 }
 
-func (s *Server) sendOrdersToExecution(ctx context.Context, orders []*pb.Order) error {
-	// Implementation to send orders to Trade Execution Service
-	// This will involve making a gRPC call to the Trade Execution Service
-	return nil
-}
+// getLatestSignals requests signals for the configured universe over the configured lookback,
+// from a strategy instance with the portfolio's parameters.
+func (p *Portfolio) getLatestSignals(ctx context.Context, config *pb.PortfolioConfiguration) (*strategypb.SignalResponse, error) {
+	p.Logger.WithFields(log.Fields{
+		"strategy":     config.StrategyName,
+		"universeSize": len(config.Universe),
+		"marketIndex":  config.MarketIndex,
+	}).Info("Fetching latest signals from Strategy Service")
 
-func (s *Server) getCurrentPortfolio(ctx context.Context) (map[string]*pb.Position, error) {
-	state, err := s.Clients.PortfolioStateClient.GetPortfolioState(ctx, &portfoliostatepb.GetPortfolioStateRequest{})
-	if err != nil {
-		return nil, err
+	now := time.Now()
+	req := &strategypb.SignalRequest{
+		Symbols:            config.Universe,
+		StartDate:          now.AddDate(0, 0, -int(config.LookbackDays)).Format("2006-01-02"),
+		EndDate:            now.Format("2006-01-02"),
+		Interval:           config.Interval,
+		MarketIndex:        config.MarketIndex,
+		StrategyName:       config.StrategyName,
+		StrategyParameters: config.StrategyParameters,
 	}
 
-	currentPortfolio := make(map[string]*pb.Position)
-	for _, pos := range state.Positions {
-		currentPortfolio[pos.Symbol] = &pb.Position{
-			Symbol:       pos.Symbol,
-			Quantity:     pos.Quantity,
-			CurrentPrice: pos.CurrentPrice,
-			MarketValue:  pos.MarketValue,
-		}
+	resp, err := p.Clients.StrategyClient.GenerateSignals(ctx, req)
+	if err != nil {
+		p.Logger.WithError(err).Error("Failed to fetch signals from Strategy Service")
+		return nil, fmt.Errorf("failed to fetch signals: %w", err)
 	}
 
-	return currentPortfolio, nil
+	p.Logger.WithField("signalCount", len(resp.Signals)).Info("Received signals from Strategy Service")
+	return resp, nil
 }
 
-func (s *Server) TriggerRebalance(ctx context.Context, req *pb.TriggerRebalanceRequest) (*pb.TriggerRebalanceResponse, error) {
-	err := s.PerformRebalance(ctx)
+func (p *Portfolio) TriggerRebalance(ctx context.Context, req *pb.TriggerRebalanceRequest) (*pb.TriggerRebalanceResponse, error) {
+	record, err := p.rebalance(ctx, triggerManual, req.DryRun)
 	if err != nil {
 		return &pb.TriggerRebalanceResponse{
-			Success: false,
-			Message: "Failed to perform rebalance: " + err.Error(),
+			Success:     false,
+			Message:     "Failed to perform rebalance: " + err.Error(),
+			Preview:     record.Plan,
+			Phases:      record.Phases,
+			RebalanceId: record.Id,
 		}, nil
 	}
-	return &pb.TriggerRebalanceResponse{
-		Success: true,
-		Message: "Rebalance performed successfully",
-	}, nil
-}
-
-func (s *Server) UpdateRebalanceSchedule(ctx context.Context, req *pb.UpdateRebalanceScheduleRequest) (*pb.UpdateRebalanceScheduleResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
 
-	s.RebalanceSchedule = req.Schedule
-	return &pb.UpdateRebalanceScheduleResponse{
-		Success: true,
-		Message: "Rebalance schedule updated successfully",
+	message := "Rebalance performed successfully"
+	if req.DryRun {
+		message = "Dry run completed, no orders were submitted"
+	} else if record.Status == rebalancePendingApproval {
+		message = "Rebalance proposed, awaiting approval until " + record.ExpiresAt
+	}
+	return &pb.TriggerRebalanceResponse{
+		Success:     true,
+		Message:     message,
+		Preview:     record.Plan,
+		Phases:      record.Phases,
+		RebalanceId: record.Id,
 	}, nil
 }
@@ -0,0 +1,263 @@
+// internal/portfolio/risk.go
+package portfolio
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	pb "momentum-trading-platform/api/proto/portfolio_service"
+)
+
+const (
+	ruleMaxPositions      = "max_positions"
+	ruleMaxPositionWeight = "max_position_weight"
+	ruleMaxSectorWeight   = "max_sector_weight"
+	ruleMaxGrossExposure  = "max_gross_exposure"
+	ruleMinCashBuffer     = "min_cash_buffer"
+	ruleMaxTurnover       = "max_turnover"
+
+	// riskTolerance absorbs rounding so that a target sized exactly at a limit does not breach it
+	riskTolerance = 1e-9
+)
+
+// validateRiskRules checks that every limit is in range.
+func validateRiskRules(rules *pb.RiskRules) error {
+	weights := map[string]*pb.RiskLimit{
+		ruleMaxPositionWeight: rules.GetMaxPositionWeight(),
+		ruleMaxSectorWeight:   rules.GetMaxSectorWeight(),
+		ruleMaxGrossExposure:  rules.GetMaxGrossExposure(),
+	}
+	for name, limit := range weights {
+		if limit.GetLimit() < 0 || limit.GetLimit() > 1 {
+			return fmt.Errorf("%s must be between 0 and 1", name)
+		}
+	}
+	if buffer := rules.GetMinCashBuffer().GetLimit(); buffer < 0 || buffer >= 1 {
+		return fmt.Errorf("%s must be at least 0 and below 1", ruleMinCashBuffer)
+	}
+	if positions := rules.GetMaxPositions().GetLimit(); positions < 0 || positions != math.Trunc(positions) {
+		return fmt.Errorf("%s must be a non-negative whole number", ruleMaxPositions)
+	}
+	if rules.GetMaxTurnover().GetLimit() < 0 {
+		return fmt.Errorf("%s must not be negative", ruleMaxTurnover)
+	}
+	return nil
+}
+
+// riskCheck applies the risk rules to a target portfolio in place. Clipping rules shrink the
+// target and never add to other positions, so value they free stays in cash. Blocking rules leave
+// the target as it is and mark the check as blocked.
+type riskCheck struct {
+	rules       *pb.RiskRules
+	current     *accountState
+	target      map[string]*pb.Position
+	sectors     map[string]string
+	totalValue  float64
+	tradedToday float64 // Value already traded today, which counts towards the turnover limit
+	violations  []*pb.RiskViolation
+	blocked     bool
+}
+
+// run checks the rules from the narrowest to the broadest, ending with turnover, which depends on
+// the final target.
+func (c *riskCheck) run() {
+	if c.rules == nil || c.totalValue <= 0 {
+		return
+	}
+	c.checkMaxPositions()
+	c.checkPositionWeights()
+	c.checkSectorWeights()
+	c.checkGrossExposure()
+	c.checkCashBuffer()
+	c.checkTurnover()
+}
+
+// breach records a violation and reports whether the target should be clipped.
+func (c *riskCheck) breach(rule, subject string, value float64, limit *pb.RiskLimit) bool {
+	c.violations = append(c.violations, &pb.RiskViolation{
+		Rule:    rule,
+		Subject: subject,
+		Value:   value,
+		Limit:   limit.Limit,
+		Action:  limit.Action,
+	})
+	if limit.Action == pb.RiskAction_BLOCK {
+		c.blocked = true
+		return false
+	}
+	return true
+}
+
+func (c *riskCheck) checkMaxPositions() {
+	limit := c.rules.MaxPositions
+	if limit.GetLimit() <= 0 || len(c.target) <= int(limit.Limit) {
+		return
+	}
+	if !c.breach(ruleMaxPositions, "", float64(len(c.target)), limit) {
+		return
+	}
+
+	// Keep the largest positions
+	symbols := c.targetSymbols()
+	sort.SliceStable(symbols, func(i, j int) bool {
+		return c.target[symbols[i]].MarketValue > c.target[symbols[j]].MarketValue
+	})
+	for _, symbol := range symbols[int(limit.Limit):] {
+		delete(c.target, symbol)
+	}
+}
+
+func (c *riskCheck) checkPositionWeights() {
+	limit := c.rules.MaxPositionWeight
+	if limit.GetLimit() <= 0 {
+		return
+	}
+	for _, symbol := range c.targetSymbols() {
+		pos := c.target[symbol]
+		weight := pos.MarketValue / c.totalValue
+		if weight > limit.Limit+riskTolerance && c.breach(ruleMaxPositionWeight, symbol, weight, limit) {
+			c.setQuantity(symbol, int32(limit.Limit*c.totalValue/pos.CurrentPrice))
+		}
+	}
+}
+
+// checkSectorWeights scales every position of a sector above the limit down proportionally.
+// Symbols without a known sector are not grouped.
+func (c *riskCheck) checkSectorWeights() {
+	limit := c.rules.MaxSectorWeight
+	if limit.GetLimit() <= 0 {
+		return
+	}
+	sectorValues := make(map[string]float64)
+	for symbol, pos := range c.target {
+		if sector := c.sectors[symbol]; sector != "" {
+			sectorValues[sector] += pos.MarketValue
+		}
+	}
+
+	sectors := make([]string, 0, len(sectorValues))
+	for sector := range sectorValues {
+		sectors = append(sectors, sector)
+	}
+	sort.Strings(sectors)
+	for _, sector := range sectors {
+		weight := sectorValues[sector] / c.totalValue
+		if weight <= limit.Limit+riskTolerance || !c.breach(ruleMaxSectorWeight, sector, weight, limit) {
+			continue
+		}
+		for _, symbol := range c.targetSymbols() {
+			if c.sectors[symbol] == sector {
+				c.scale(symbol, limit.Limit/weight)
+			}
+		}
+	}
+}
+
+func (c *riskCheck) checkGrossExposure() {
+	limit := c.rules.MaxGrossExposure
+	if limit.GetLimit() <= 0 {
+		return
+	}
+	exposure := c.investedValue() / c.totalValue
+	if exposure > limit.Limit+riskTolerance && c.breach(ruleMaxGrossExposure, "", exposure, limit) {
+		c.scaleAll(limit.Limit / exposure)
+	}
+}
+
+func (c *riskCheck) checkCashBuffer() {
+	limit := c.rules.MinCashBuffer
+	if limit.GetLimit() <= 0 {
+		return
+	}
+	exposure := c.investedValue() / c.totalValue
+	cashWeight := 1 - exposure
+	if cashWeight < limit.Limit-riskTolerance && c.breach(ruleMinCashBuffer, "", cashWeight, limit) {
+		c.scaleAll((1 - limit.Limit) / exposure)
+	}
+}
+
+// checkTurnover scales every trade towards the current position so that the value traded today,
+// earlier runs included, fits the limit. A position being closed may then be kept partially.
+func (c *riskCheck) checkTurnover() {
+	limit := c.rules.MaxTurnover
+	if limit.GetLimit() <= 0 {
+		return
+	}
+	earlier := c.tradedToday / c.totalValue
+	turnover := tradedValue(c.current.positions, c.target) / c.totalValue
+	if turnover <= riskTolerance || earlier+turnover <= limit.Limit+riskTolerance || !c.breach(ruleMaxTurnover, "", earlier+turnover, limit) {
+		return
+	}
+
+	factor := max(limit.Limit-earlier, 0) / turnover
+	for _, symbol := range unionSymbols(c.current.positions, c.target) {
+		currentQuantity, targetQuantity, price := positionChange(c.current.positions, c.target, symbol)
+		if _, wanted := c.target[symbol]; !wanted {
+			c.target[symbol] = &pb.Position{Symbol: symbol, CurrentPrice: price}
+		}
+		c.setQuantity(symbol, currentQuantity+int32(float64(targetQuantity-currentQuantity)*factor))
+	}
+}
+
+func (c *riskCheck) targetSymbols() []string {
+	symbols := make([]string, 0, len(c.target))
+	for symbol := range c.target {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+func (c *riskCheck) investedValue() float64 {
+	invested := 0.0
+	for _, pos := range c.target {
+		invested += pos.MarketValue
+	}
+	return invested
+}
+
+func (c *riskCheck) scaleAll(factor float64) {
+	for _, symbol := range c.targetSymbols() {
+		c.scale(symbol, factor)
+	}
+}
+
+func (c *riskCheck) scale(symbol string, factor float64) {
+	c.setQuantity(symbol, int32(float64(c.target[symbol].Quantity)*factor))
+}
+
+// setQuantity resizes a target position, dropping it when nothing is left.
+func (c *riskCheck) setQuantity(symbol string, quantity int32) {
+	if quantity <= 0 {
+		delete(c.target, symbol)
+		return
+	}
+	pos := c.target[symbol]
+	pos.Quantity = quantity
+	pos.MarketValue = float64(quantity) * pos.CurrentPrice
+}
+
+// tradedValue is the value that moves the current portfolio to the target, buys and sells alike.
+func tradedValue(current, target map[string]*pb.Position) float64 {
+	traded := 0.0
+	for _, symbol := range unionSymbols(current, target) {
+		currentQuantity, targetQuantity, price := positionChange(current, target, symbol)
+		traded += math.Abs(float64(targetQuantity-currentQuantity)) * price
+	}
+	return traded
+}
+
+// describeViolations summarizes violations for error messages.
+func describeViolations(violations []*pb.RiskViolation) string {
+	parts := make([]string, 0, len(violations))
+	for _, v := range violations {
+		rule := v.Rule
+		if v.Subject != "" {
+			rule += " " + v.Subject
+		}
+		parts = append(parts, fmt.Sprintf("%s at %.4g against a limit of %.4g", rule, v.Value, v.Limit))
+	}
+	return strings.Join(parts, ", ")
+}
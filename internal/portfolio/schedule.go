@@ -0,0 +1,259 @@
+// internal/portfolio/schedule.go
+package portfolio
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"momentum-trading-platform/internal/utils"
+)
+
+const (
+	defaultRunHour  = 10 // Exchange time, after the open so that the day's prices are available
+	maxScheduleDays = 800
+)
+
+// Schedule decides when rebalances run. Times are evaluated in the exchange time zone and every
+// run falls on a trading day.
+type Schedule interface {
+	// Next returns the first run strictly after t, or the zero time when there is none.
+	Next(t time.Time) time.Time
+	String() string
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+	"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+}
+
+var ordinals = map[string]int{"first": 1, "second": 2, "third": 3, "fourth": 4, "last": -1}
+
+// ParseSchedule parses a rebalance schedule. Calendar schedules are "daily", "weekly" (every
+// Wednesday), "monthly" (the second Wednesday of the month), "every <weekday>" and "<ordinal>
+// <weekday> of month" with ordinals first to fourth or last, optionally followed by "at HH:MM"; a
+// run date that is not a trading day moves to the next trading day. Anything with five fields is
+// a cron expression ("minute hour day-of-month month day-of-week"), whose occurrences on
+// non-trading days are skipped.
+func ParseSchedule(spec string) (Schedule, error) {
+	normalized := strings.ToLower(strings.Join(strings.Fields(spec), " "))
+	if normalized == "" {
+		return nil, fmt.Errorf("schedule is empty")
+	}
+	if fields := strings.Fields(normalized); len(fields) == 5 && !strings.Contains(normalized, " of ") {
+		return parseCron(spec, fields)
+	}
+
+	schedule := &calendarSchedule{spec: spec, hour: defaultRunHour}
+	rule := normalized
+	if i := strings.LastIndex(normalized, " at "); i >= 0 {
+		clock, err := time.Parse("15:04", normalized[i+4:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid time %q, expected HH:MM", normalized[i+4:])
+		}
+		schedule.hour, schedule.minute = clock.Hour(), clock.Minute()
+		rule = normalized[:i]
+	}
+
+	switch rule {
+	case "daily", "every day", "every trading day":
+		schedule.matches = func(time.Time) bool { return true }
+		return schedule, nil
+	case "weekly":
+		rule = "every wednesday"
+	case "monthly":
+		rule = "second wednesday of month"
+	}
+
+	if weekday, ok := strings.CutPrefix(rule, "every "); ok {
+		day, found := weekdays[strings.TrimSuffix(weekday, "s")]
+		if !found {
+			return nil, fmt.Errorf("unknown weekday %q", weekday)
+		}
+		schedule.matches = func(d time.Time) bool { return d.Weekday() == day }
+		return schedule, nil
+	}
+
+	if monthly, ok := cutMonthSuffix(rule); ok {
+		parts := strings.Fields(monthly)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid monthly schedule %q, expected e.g. \"second wednesday of month\"", spec)
+		}
+		n, okOrdinal := ordinals[parts[0]]
+		day, okWeekday := weekdays[parts[1]]
+		if !okOrdinal || !okWeekday {
+			return nil, fmt.Errorf("invalid monthly schedule %q, expected e.g. \"second wednesday of month\"", spec)
+		}
+		schedule.matches = func(d time.Time) bool {
+			if d.Weekday() != day {
+				return false
+			}
+			if n < 0 {
+				return d.AddDate(0, 0, 7).Month() != d.Month()
+			}
+			return (d.Day()-1)/7 == n-1
+		}
+		return schedule, nil
+	}
+
+	return nil, fmt.Errorf("unsupported schedule %q", spec)
+}
+
+func cutMonthSuffix(rule string) (string, bool) {
+	if before, ok := strings.CutSuffix(rule, " of the month"); ok {
+		return before, true
+	}
+	return strings.CutSuffix(rule, " of month")
+}
+
+// calendarSchedule runs at a fixed time on the dates matched by a rule, moving a date that is not
+// a trading day to the next trading day.
+type calendarSchedule struct {
+	spec         string
+	hour, minute int
+	matches      func(date time.Time) bool
+}
+
+func (s *calendarSchedule) String() string { return s.spec }
+
+func (s *calendarSchedule) Next(t time.Time) time.Time {
+	// A rule date shortly before t may have moved past t, so the search starts a week earlier
+	local := t.In(utils.MarketLocation)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, utils.MarketLocation).AddDate(0, 0, -7)
+	for i := 0; i < maxScheduleDays; i++ {
+		date := day.AddDate(0, 0, i)
+		if !s.matches(date) {
+			continue
+		}
+		tradingDay := utils.NextTradingDay(date)
+		run := time.Date(tradingDay.Year(), tradingDay.Month(), tradingDay.Day(), s.hour, s.minute, 0, 0, utils.MarketLocation)
+		if run.After(t) {
+			return run
+		}
+	}
+	return time.Time{}
+}
+
+// cronSchedule is a standard five-field cron expression evaluated in the exchange time zone.
+type cronSchedule struct {
+	spec                                   string
+	minutes, hours, days, months, weekdays []bool
+	daysRestricted, weekdaysRestricted     bool
+}
+
+func parseCron(spec string, fields []string) (*cronSchedule, error) {
+	s := &cronSchedule{spec: spec}
+	var err error
+	if s.minutes, _, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("invalid cron minute: %v", err)
+	}
+	if s.hours, _, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("invalid cron hour: %v", err)
+	}
+	if s.days, s.daysRestricted, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("invalid cron day of month: %v", err)
+	}
+	if s.months, _, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("invalid cron month: %v", err)
+	}
+	if s.weekdays, s.weekdaysRestricted, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("invalid cron day of week: %v", err)
+	}
+	// Both 0 and 7 mean Sunday
+	s.weekdays[0] = s.weekdays[0] || s.weekdays[7]
+	return s, nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges and steps ("*/15", "1-5",
+// "1,15") and reports whether the field restricts anything.
+func parseCronField(field string, low, high int) ([]bool, bool, error) {
+	allowed := make([]bool, high+1)
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if base, stepText, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(stepText)
+			if err != nil || n <= 0 {
+				return nil, false, fmt.Errorf("invalid step %q", stepText)
+			}
+			part, step = base, n
+		}
+
+		start, end := low, high
+		if part != "*" {
+			from, to, isRange := strings.Cut(part, "-")
+			var err error
+			if start, err = strconv.Atoi(from); err != nil {
+				return nil, false, fmt.Errorf("invalid value %q", from)
+			}
+			end = start
+			if isRange {
+				if end, err = strconv.Atoi(to); err != nil {
+					return nil, false, fmt.Errorf("invalid value %q", to)
+				}
+			} else if step > 1 {
+				end = high
+			}
+		}
+		if start < low || end > high || start > end {
+			return nil, false, fmt.Errorf("%q is outside %d-%d", part, low, high)
+		}
+		for v := start; v <= end; v += step {
+			allowed[v] = true
+		}
+	}
+	return allowed, field != "*", nil
+}
+
+func (s *cronSchedule) String() string { return s.spec }
+
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	local := t.In(utils.MarketLocation)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, utils.MarketLocation)
+	for i := 0; i < maxScheduleDays; i++ {
+		date := day.AddDate(0, 0, i)
+		if !s.matchesDate(date) || !utils.IsTradingDay(date) {
+			continue
+		}
+		for hour := 0; hour < 24; hour++ {
+			if !s.hours[hour] {
+				continue
+			}
+			for minute := 0; minute < 60; minute++ {
+				if !s.minutes[minute] {
+					continue
+				}
+				if run := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, utils.MarketLocation); run.After(t) {
+					return run
+				}
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// matchesDate applies the cron rule that a day matches either field when both the day of month
+// and the day of week are restricted.
+func (s *cronSchedule) matchesDate(date time.Time) bool {
+	if !s.months[date.Month()] {
+		return false
+	}
+	dayMatch, weekdayMatch := s.days[date.Day()], s.weekdays[date.Weekday()]
+	if s.daysRestricted && s.weekdaysRestricted {
+		return dayMatch || weekdayMatch
+	}
+	return dayMatch && weekdayMatch
+}
+
+// upcomingRuns returns the next n runs after t.
+func upcomingRuns(schedule Schedule, t time.Time, n int) []time.Time {
+	var runs []time.Time
+	for len(runs) < n {
+		t = schedule.Next(t)
+		if t.IsZero() {
+			break
+		}
+		runs = append(runs, t)
+	}
+	return runs
+}
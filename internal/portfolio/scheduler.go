@@ -0,0 +1,245 @@
+// internal/portfolio/scheduler.go
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/portfolio_service"
+	"momentum-trading-platform/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	rebalanceSucceeded = "SUCCEEDED"
+	rebalanceFailed    = "FAILED"
+
+	scheduledRebalanceTimeout = 10 * time.Minute
+	defaultUpcomingRuns       = 5
+	maxUpcomingRuns           = 50
+)
+
+// loadSchedule restores the schedule and its last and next runs. A portfolio only rebalances
+// automatically once a schedule has been set for it. A run that was missed while the service was
+// down is still made when the service comes back on the same trading day; older missed runs are
+// skipped.
+func (p *Portfolio) loadSchedule() error {
+	state, err := p.loadScheduleState()
+	if err != nil {
+		return fmt.Errorf("failed to load rebalance schedule: %v", err)
+	}
+	if state == nil {
+		p.Logger.Info("📅 No rebalance schedule set, rebalances only run when triggered")
+		return nil
+	}
+
+	var schedule Schedule
+	if state.schedule != "" {
+		if schedule, err = ParseSchedule(state.schedule); err != nil {
+			p.Logger.WithError(err).Warn("❗ Stored rebalance schedule is invalid, rebalances only run when triggered until a new one is set")
+			schedule, state.schedule = nil, ""
+		}
+	}
+
+	now := time.Now()
+	switch {
+	case schedule == nil:
+		state.nextRun = time.Time{}
+	case !state.nextRun.IsZero() && state.nextRun.Before(now) && !sameMarketDay(state.nextRun, now):
+		p.Logger.WithField("missedRun", state.nextRun).Warn("❗ Skipping a scheduled rebalance missed while the service was down")
+		state.nextRun = schedule.Next(now)
+	case state.nextRun.IsZero():
+		state.nextRun = schedule.Next(now)
+	}
+
+	p.settingsMu.Lock()
+	p.RebalanceSchedule = schedule
+	p.scheduleState = *state
+	p.settingsMu.Unlock()
+
+	p.Logger.WithFields(log.Fields{
+		"schedule": state.schedule,
+		"nextRun":  state.nextRun,
+	}).Info("📅 Loaded rebalance schedule")
+	return p.storeScheduleState(*state)
+}
+
+func sameMarketDay(a, b time.Time) bool {
+	a, b = a.In(utils.MarketLocation), b.In(utils.MarketLocation)
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
+
+// startScheduler runs the portfolio's scheduled rebalances in the background until ctx is
+// cancelled.
+func (p *Portfolio) startScheduler(ctx context.Context) {
+	go p.runScheduler(ctx)
+}
+
+func (p *Portfolio) runScheduler(ctx context.Context) {
+	for {
+		p.settingsMu.Lock()
+		next := p.scheduleState.nextRun
+		p.settingsMu.Unlock()
+
+		// Without an upcoming run the scheduler only waits for a new schedule
+		var timer *time.Timer
+		var fire <-chan time.Time
+		if !next.IsZero() {
+			timer = time.NewTimer(time.Until(next))
+			fire = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			stopTimer(timer)
+			return
+		case <-p.scheduleChanged:
+			stopTimer(timer)
+		case <-fire:
+			p.runScheduledRebalance(ctx, next)
+		}
+	}
+}
+
+func stopTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
+
+func (p *Portfolio) runScheduledRebalance(ctx context.Context, scheduled time.Time) {
+	p.Logger.WithField("scheduled", scheduled).Info("⏰ Starting scheduled rebalance")
+
+	if utils.IsTradingDay(time.Now()) {
+		rebalanceCtx, cancel := context.WithTimeout(ctx, scheduledRebalanceTimeout)
+		if err := p.PerformRebalance(rebalanceCtx, triggerScheduled); err != nil {
+			p.Logger.WithError(err).Error("❌ Scheduled rebalance failed")
+		}
+		cancel()
+	} else {
+		p.Logger.Warn("❗ Skipping scheduled rebalance on a non-trading day")
+	}
+
+	p.settingsMu.Lock()
+	defer p.settingsMu.Unlock()
+	// A schedule changed during the rebalance has already set its own next run
+	if p.scheduleState.nextRun.Equal(scheduled) {
+		p.scheduleState.nextRun = p.RebalanceSchedule.Next(maxTime(time.Now(), scheduled))
+	}
+	if err := p.storeScheduleState(p.scheduleState); err != nil {
+		p.Logger.WithError(err).Error("❌ Failed to store rebalance schedule")
+	}
+	p.Logger.WithField("nextRun", p.scheduleState.nextRun).Info("📅 Next rebalance scheduled")
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// recordRebalance stores the outcome of a rebalance, scheduled or triggered manually, or of the
+// decision on its proposal.
+func (p *Portfolio) recordRebalance(at time.Time, status, message string) {
+	p.settingsMu.Lock()
+	defer p.settingsMu.Unlock()
+
+	p.scheduleState.lastRun = at
+	p.scheduleState.lastStatus = status
+	p.scheduleState.lastError = message
+	if storeErr := p.storeScheduleState(p.scheduleState); storeErr != nil {
+		p.Logger.WithError(storeErr).Error("❌ Failed to store rebalance run")
+	}
+}
+
+// UpdateRebalanceSchedule sets the schedule of automatic rebalances. An empty schedule turns them
+// off, leaving only triggered rebalances.
+func (p *Portfolio) UpdateRebalanceSchedule(ctx context.Context, req *pb.UpdateRebalanceScheduleRequest) (*pb.UpdateRebalanceScheduleResponse, error) {
+	var schedule Schedule
+	if strings.TrimSpace(req.Schedule) != "" {
+		var err error
+		if schedule, err = ParseSchedule(req.Schedule); err != nil {
+			return &pb.UpdateRebalanceScheduleResponse{
+				Success: false,
+				Message: "Invalid rebalance schedule: " + err.Error(),
+			}, nil
+		}
+	}
+
+	p.settingsMu.Lock()
+	p.RebalanceSchedule = schedule
+	p.scheduleState.schedule, p.scheduleState.nextRun = "", time.Time{}
+	if schedule != nil {
+		p.scheduleState.schedule = schedule.String()
+		p.scheduleState.nextRun = schedule.Next(time.Now())
+	}
+	state := p.scheduleState
+	err := p.storeScheduleState(state)
+	p.settingsMu.Unlock()
+	if err != nil {
+		p.Logger.WithError(err).Error("❌ Failed to store rebalance schedule")
+		return &pb.UpdateRebalanceScheduleResponse{
+			Success: false,
+			Message: "Failed to store rebalance schedule: " + err.Error(),
+		}, nil
+	}
+
+	// Wake the scheduler so that it waits for the new next run
+	select {
+	case p.scheduleChanged <- struct{}{}:
+	default:
+	}
+
+	p.Logger.WithFields(log.Fields{
+		"schedule": state.schedule,
+		"nextRun":  state.nextRun,
+	}).Info("📅 Rebalance schedule updated")
+
+	return &pb.UpdateRebalanceScheduleResponse{
+		Success: true,
+		Message: "Rebalance schedule updated successfully",
+		NextRun: formatScheduleTime(state.nextRun),
+	}, nil
+}
+
+func (p *Portfolio) GetRebalanceSchedule(ctx context.Context, req *pb.GetRebalanceScheduleRequest) (*pb.RebalanceSchedule, error) {
+	upcoming := int(req.Upcoming)
+	if upcoming <= 0 {
+		upcoming = defaultUpcomingRuns
+	}
+	upcoming = min(upcoming, maxUpcomingRuns)
+
+	p.settingsMu.Lock()
+	state := p.scheduleState
+	schedule := p.RebalanceSchedule
+	p.settingsMu.Unlock()
+
+	var runs []string
+	if !state.nextRun.IsZero() {
+		runs = append(runs, formatScheduleTime(state.nextRun))
+		for _, run := range upcomingRuns(schedule, state.nextRun, upcoming-1) {
+			runs = append(runs, formatScheduleTime(run))
+		}
+	}
+
+	return &pb.RebalanceSchedule{
+		Schedule:     state.schedule,
+		Timezone:     utils.MarketLocation.String(),
+		LastRun:      formatScheduleTime(state.lastRun),
+		LastStatus:   state.lastStatus,
+		LastError:    state.lastError,
+		NextRun:      formatScheduleTime(state.nextRun),
+		UpcomingRuns: runs,
+	}, nil
+}
+
+func formatScheduleTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(utils.MarketLocation).Format(time.RFC3339)
+}
@@ -3,27 +3,52 @@ package portfolio
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"slices"
 	"sync"
 	"time"
 
 	pb "momentum-trading-platform/api/proto/portfolio_service"
 	portfoliostatepb "momentum-trading-platform/api/proto/portfolio_state_service"
+	"momentum-trading-platform/internal/utils"
 
 	log "github.com/sirupsen/logrus"
 )
 
+// Server manages the portfolios of one deployment and routes each request to the portfolio it
+// names. Portfolios share the service clients and the database; each has its own account,
+// configuration, schedule and rebalance lock.
 type Server struct {
 	pb.UnimplementedPortfolioServiceServer
-	Logger            *log.Logger
+	Logger       *log.Logger
+	Clients      *Clients
+	metadata     *utils.SectorCache
+	DB           *sql.DB
+	portfolios   map[string]*Portfolio
+	creating     map[string]bool // ids reserved by CreatePortfolio calls still in progress
+	schedulerCtx context.Context // set once the schedulers run, so that new portfolios start theirs
+	portfoliosMu sync.RWMutex
+}
+
+// Portfolio is one managed account with its own strategy, universe and schedule.
+type Portfolio struct {
+	ID                string
+	Logger            *log.Entry
 	Clients           *Clients
+	metadata          *utils.SectorCache
+	DB                *sql.DB
 	DesiredPortfolio  map[string]*pb.Position
 	CashBalance       float64
-	RebalanceSchedule string
-	lastRebalanceTime time.Time
+	Configuration     *pb.PortfolioConfiguration
+	RebalanceSchedule Schedule
+	scheduleState     scheduleState
+	scheduleChanged   chan struct{}
 	mu                sync.Mutex
+	settingsMu        sync.Mutex // guards the schedule and the configuration, which are read while a rebalance holds mu
 }
 
-func NewServer(clients *Clients) (*Server, error) {
+func NewServer(clients *Clients, db *sql.DB) (*Server, error) {
 	logger := log.New()
 	logger.SetLevel(log.TraceLevel)
 	logger.SetFormatter(&log.TextFormatter{
@@ -31,51 +56,93 @@ func NewServer(clients *Clients) (*Server, error) {
 	})
 
 	s := &Server{
-		Logger:            logger,
-		Clients:           clients,
-		DesiredPortfolio:  make(map[string]*pb.Position),
-		RebalanceSchedule: "weekly",
-		lastRebalanceTime: time.Now(),
+		Logger:     logger,
+		Clients:    clients,
+		metadata:   utils.NewSectorCache(clients.DataClient),
+		DB:         db,
+		portfolios: make(map[string]*Portfolio),
+		creating:   make(map[string]bool),
 	}
 
-	// Load the previous state
-	err := s.loadState()
+	if err := s.initDatabase(); err != nil {
+		return nil, fmt.Errorf("failed to initialize database: %v", err)
+	}
+	ids, err := s.loadPortfolioIDs()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to load portfolios: %v", err)
+	}
+	if !slices.Contains(ids, utils.DefaultPortfolioID) {
+		ids = append(ids, utils.DefaultPortfolioID)
+	}
+	for _, id := range ids {
+		p := s.newPortfolio(id)
+		if err := p.load(); err != nil {
+			return nil, fmt.Errorf("failed to load portfolio %s: %w", id, err)
+		}
+		s.portfolios[id] = p
 	}
 
+	s.Logger.WithField("portfolios", len(s.portfolios)).Info("Loaded portfolios")
 	return s, nil
 }
 
-func (s *Server) loadState() error {
+func (s *Server) newPortfolio(id string) *Portfolio {
+	return &Portfolio{
+		ID:               id,
+		Logger:           s.Logger.WithField("portfolioId", id),
+		Clients:          s.Clients,
+		metadata:         s.metadata,
+		DB:               s.DB,
+		DesiredPortfolio: make(map[string]*pb.Position),
+		scheduleChanged:  make(chan struct{}, 1),
+	}
+}
+
+// load restores the portfolio's schedule and configuration and reads its account.
+func (p *Portfolio) load() error {
+	if err := p.loadSchedule(); err != nil {
+		return err
+	}
+	if err := p.loadConfiguration(); err != nil {
+		return err
+	}
+
+	// Load the previous state
+	return p.loadState()
+}
+
+func (p *Portfolio) loadState() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	// Get the current portfolio state from the Portfolio State Service
-	portfolioState, err := s.Clients.PortfolioStateClient.GetPortfolioState(ctx, &portfoliostatepb.GetPortfolioStateRequest{})
+	portfolioState, err := p.Clients.PortfolioStateClient.GetPortfolioState(ctx, &portfoliostatepb.GetPortfolioStateRequest{
+		PortfolioId: p.ID,
+	})
 	if err != nil {
-		s.Logger.WithError(err).Error("Failed to load portfolio state")
+		p.Logger.WithError(err).Error("Failed to load portfolio state")
 		return err
 	}
 
-	// Update the server's desired portfolio state based on the actual state
-	s.DesiredPortfolio = make(map[string]*pb.Position)
+	// Update the desired portfolio state based on the actual state
+	p.DesiredPortfolio = make(map[string]*pb.Position)
 	for _, position := range portfolioState.Positions {
-		s.DesiredPortfolio[position.Symbol] = &pb.Position{
+		p.DesiredPortfolio[position.Symbol] = &pb.Position{
 			Symbol:       position.Symbol,
 			Quantity:     position.Quantity,
 			CurrentPrice: position.CurrentPrice,
 			MarketValue:  position.MarketValue,
 		}
 	}
+	p.CashBalance = portfolioState.CashBalance
 
-	s.Logger.Info("Loaded current portfolio state as initial desired state")
+	p.Logger.Info("Loaded current portfolio state as initial desired state")
 	return nil
 }
 
-func (s *Server) calculateTotalValue() float64 {
-	total := s.CashBalance
-	for _, pos := range s.DesiredPortfolio {
+func (p *Portfolio) calculateTotalValue() float64 {
+	total := p.CashBalance
+	for _, pos := range p.DesiredPortfolio {
 		total += pos.MarketValue
 	}
 	return total
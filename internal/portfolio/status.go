@@ -6,18 +6,18 @@ import (
 	pb "momentum-trading-platform/api/proto/portfolio_service"
 )
 
-func (s *Server) GetDesiredPortfolioState(ctx context.Context, req *pb.GetDesiredPortfolioStateRequest) (*pb.PortfolioState, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (p *Portfolio) GetDesiredPortfolioState(ctx context.Context, req *pb.GetDesiredPortfolioStateRequest) (*pb.PortfolioState, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
-	positions := make([]*pb.Position, 0, len(s.DesiredPortfolio))
-	for _, pos := range s.DesiredPortfolio {
+	positions := make([]*pb.Position, 0, len(p.DesiredPortfolio))
+	for _, pos := range p.DesiredPortfolio {
 		positions = append(positions, pos)
 	}
 
 	return &pb.PortfolioState{
 		Positions:   positions,
-		CashBalance: s.CashBalance,
-		TotalValue:  s.calculateTotalValue(),
+		CashBalance: p.CashBalance,
+		TotalValue:  p.calculateTotalValue(),
 	}, nil
 }
@@ -3,10 +3,15 @@ package portfolio
 
 import (
 	"context"
+	"slices"
+	"sort"
+	"time"
+
 	pb "momentum-trading-platform/api/proto/portfolio_service"
 	portfoliostatepb "momentum-trading-platform/api/proto/portfolio_state_service"
 	strategypb "momentum-trading-platform/api/proto/strategy_service"
 	tradepb "momentum-trading-platform/api/proto/trade_execution_service"
+	"momentum-trading-platform/internal/utils"
 
 	log "github.com/sirupsen/logrus"
 
@@ -14,55 +19,185 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func (s *Server) GenerateAndSubmitOrders(ctx context.Context, req *pb.GenerateOrdersRequest) (*pb.GenerateOrdersResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (p *Portfolio) GenerateAndSubmitOrders(ctx context.Context, req *pb.GenerateOrdersRequest) (*pb.GenerateOrdersResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.generateAndSubmitOrders(ctx, req)
+}
 
-	s.Logger.Info("Generating and submitting orders based on signals")
+// generateAndSubmitOrders is GenerateAndSubmitOrders for callers that already hold mu.
+func (p *Portfolio) generateAndSubmitOrders(ctx context.Context, req *pb.GenerateOrdersRequest) (*pb.GenerateOrdersResponse, error) {
+	p.Logger.Info("Generating and submitting orders based on signals")
 
-	// Get current portfolio state
-	currentState, err := s.getCurrentPortfolioState(ctx)
+	plan, err := p.planOrders(ctx, &strategypb.SignalResponse{
+		Signals:        req.Signals,
+		TargetExposure: req.TargetExposure,
+	})
 	if err != nil {
-		s.Logger.WithError(err).Error("Failed to get current portfolio state")
-		return nil, status.Errorf(codes.Internal, "failed to get current portfolio state: %v", err)
+		return nil, err
+	}
+	if plan.blocked {
+		return nil, status.Errorf(codes.FailedPrecondition, "orders blocked by risk rules: %s", describeViolations(plan.violations))
+	}
+	if _, err := p.submitPlan(ctx, plan); err != nil {
+		return nil, err
 	}
 
-	// Calculate desired portfolio
-	desiredPortfolio, err := s.calculateDesiredPortfolio(req.Signals)
+	return &pb.GenerateOrdersResponse{
+		Orders: plan.orders,
+	}, nil
+}
+
+// submitPlan makes the plan's target the desired portfolio and executes its orders, sells before
+// buys. It returns the execution phases, which are empty when there was nothing to trade.
+func (p *Portfolio) submitPlan(ctx context.Context, plan *rebalancePlan) ([]*pb.ExecutionPhase, error) {
+	// Update desired portfolio state (this is internal to the Portfolio Service)
+	p.DesiredPortfolio = plan.target
+	p.CashBalance = plan.targetCash()
+
+	if len(plan.orders) == 0 {
+		p.Logger.Info("Portfolio is already at its target, no orders to submit")
+		return nil, nil
+	}
+
+	phases, err := p.executePlan(ctx, plan)
 	if err != nil {
-		s.Logger.WithError(err).Error("Failed to calculate desired portfolio")
-		return nil, status.Errorf(codes.Internal, "failed to calculate desired portfolio: %v", err)
+		p.Logger.WithError(err).Error("Failed to execute orders")
+		return phases, status.Errorf(codes.Internal, "failed to execute orders: %v", err)
 	}
 
-	// Generate orders
-	orders := s.generateOrders(currentState, desiredPortfolio)
+	p.Logger.WithField("orderCount", len(plan.orders)).Info("Orders generated and submitted successfully")
+	return phases, nil
+}
 
-	// Update desired portfolio state (this is internal to the Portfolio Service)
-	s.DesiredPortfolio = desiredPortfolio
+// accountState is the account as reported by the Portfolio State Service.
+type accountState struct {
+	positions map[string]*pb.Position
+	cash      float64
+}
 
-	// Submit orders to Trade Execution Service
-	executionResp, err := s.Clients.TradeExecutionClient.ExecuteTrades(ctx, &tradepb.ExecuteTradesRequest{
-		Orders: orders,
-	})
+func (a *accountState) totalValue() float64 {
+	total := a.cash
+	for _, pos := range a.positions {
+		total += pos.MarketValue
+	}
+	return total
+}
+
+// rebalancePlan is the target portfolio and the orders that reach it from the current account,
+// before anything is submitted.
+type rebalancePlan struct {
+	current    *accountState
+	target     map[string]*pb.Position
+	orders     []*pb.Order
+	kept       map[string]string // Why positions were left as they are, by symbol
+	avoided    float64           // Traded value saved by the rebalance bands
+	violations []*pb.RiskViolation
+	blocked    bool // A blocking risk rule was breached
+}
+
+// symbols returns the symbols the plan holds, targets or decided to leave alone, sorted.
+func (p *rebalancePlan) symbols() []string {
+	symbols := unionSymbols(p.current.positions, p.target)
+	for symbol := range p.kept {
+		if !slices.Contains(symbols, symbol) {
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// targetCash is the cash left once the orders fill at their reference prices.
+func (p *rebalancePlan) targetCash() float64 {
+	cash := p.current.cash
+	for _, order := range p.orders {
+		cash -= float64(order.Quantity) * order.Price
+	}
+	return cash
+}
+
+// planOrders works out the desired portfolio and its orders without submitting them. Held
+// positions are valued at the signal prices where there are any, so that the portfolio value and
+// the orders use the same prices. The allocation passes through the rebalance bands and then the
+// risk rules, which take precedence.
+func (p *Portfolio) planOrders(ctx context.Context, signalResp *strategypb.SignalResponse) (*rebalancePlan, error) {
+	current, err := p.getCurrentPortfolioState(ctx)
 	if err != nil {
-		s.Logger.WithError(err).Error("Failed to submit orders to Trade Execution Service")
-		return nil, status.Errorf(codes.Internal, "failed to submit orders: %v", err)
+		p.Logger.WithError(err).Error("Failed to get current portfolio state")
+		return nil, status.Errorf(codes.Internal, "failed to get current portfolio state: %v", err)
+	}
+	for _, signal := range signalResp.Signals {
+		if pos, held := current.positions[signal.Symbol]; held && signal.CurrentPrice > 0 {
+			pos.CurrentPrice = signal.CurrentPrice
+			pos.MarketValue = float64(pos.Quantity) * signal.CurrentPrice
+		}
 	}
 
-	s.Logger.WithFields(log.Fields{
-		"orderCount":  len(orders),
-		"executionId": executionResp.ExecutionId,
-	}).Info("Orders generated and submitted successfully")
+	config := p.configuration()
+	buffered := bufferedHoldings(config.RebalanceBands, signalResp, current)
+	desiredPortfolio, err := p.calculateDesiredPortfolio(ctx, signalResp.Signals, current, utils.TargetExposure(signalResp), buffered)
+	if err != nil {
+		p.Logger.WithError(err).Error("Failed to calculate desired portfolio")
+		return nil, status.Errorf(codes.Internal, "failed to calculate desired portfolio: %v", err)
+	}
 
-	return &pb.GenerateOrdersResponse{
-		Orders: orders,
+	kept, avoided := applyBands(config.RebalanceBands, current, desiredPortfolio)
+	for symbol := range buffered {
+		kept[symbol] = keptBufferRank
+	}
+	if len(kept) > 0 {
+		p.Logger.WithFields(log.Fields{
+			"keptPositions": len(kept),
+			"avoidedValue":  avoided,
+		}).Info("Rebalance bands kept positions unchanged")
+	}
+
+	check := &riskCheck{
+		rules:      config.RiskRules,
+		current:    current,
+		target:     desiredPortfolio,
+		totalValue: current.totalValue(),
+	}
+	if check.rules.GetMaxTurnover().GetLimit() > 0 {
+		if check.tradedToday, err = p.loadTradedValueSince(utils.MarketDayStart(time.Now())); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load today's traded value for the turnover limit: %v", err)
+		}
+	}
+	if check.rules.GetMaxSectorWeight().GetLimit() > 0 {
+		if check.sectors, err = p.lookupSectors(current, desiredPortfolio); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to look up sectors for the sector limit: %v", err)
+		}
+	}
+	check.run()
+	if len(check.violations) > 0 {
+		p.Logger.WithFields(log.Fields{
+			"violations": describeViolations(check.violations),
+			"blocked":    check.blocked,
+		}).Warn("❗ Target portfolio breaches risk rules")
+	}
+
+	return &rebalancePlan{
+		current:    current,
+		target:     check.target,
+		orders:     p.generateOrders(current.positions, check.target),
+		kept:       kept,
+		avoided:    avoided,
+		violations: check.violations,
+		blocked:    check.blocked,
 	}, nil
 }
 
-// Keep the generateOrders, calculateDesiredPortfolio, and getCurrentPortfolioState methods as they are
+// lookupSectors returns the sectors of the current and target symbols.
+func (p *Portfolio) lookupSectors(current *accountState, target map[string]*pb.Position) (map[string]string, error) {
+	return p.metadata.Sectors(unionSymbols(current.positions, target))
+}
 
-func (s *Server) getCurrentPortfolioState(ctx context.Context) (map[string]*pb.Position, error) {
-	state, err := s.Clients.PortfolioStateClient.GetPortfolioState(ctx, &portfoliostatepb.GetPortfolioStateRequest{})
+func (p *Portfolio) getCurrentPortfolioState(ctx context.Context) (*accountState, error) {
+	state, err := p.Clients.PortfolioStateClient.GetPortfolioState(ctx, &portfoliostatepb.GetPortfolioStateRequest{
+		PortfolioId: p.ID,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -77,77 +212,128 @@ func (s *Server) getCurrentPortfolioState(ctx context.Context) (map[string]*pb.P
 		}
 	}
 
-	return currentPortfolio, nil
+	return &accountState{positions: currentPortfolio, cash: state.CashBalance}, nil
 }
 
-func (s *Server) calculateDesiredPortfolio(signals []*strategypb.StockSignal) (map[string]*pb.Position, error) {
+// calculateDesiredPortfolio allocates targetExposure of the portfolio value across BUY signals,
+// with weights from the configured optimizer. HOLD signals keep held positions at their current
+// quantity and never open new ones.
+// Retained and held positions are kept as they are and their value is not allocated again.
+func (p *Portfolio) calculateDesiredPortfolio(ctx context.Context, signals []*strategypb.StockSignal, current *accountState, targetExposure float64, retained map[string]*pb.Position) (map[string]*pb.Position, error) {
 	desiredPortfolio := make(map[string]*pb.Position)
-	totalRiskUnits := 0.0
-	for _, signal := range signals {
-		if signal.Signal == strategypb.SignalType_BUY {
-			totalRiskUnits += signal.RiskUnit
-		}
+	totalValue := current.totalValue() * targetExposure
+	for symbol, pos := range retained {
+		desiredPortfolio[symbol] = pos
+		totalValue -= pos.MarketValue
 	}
 
-	totalValue := s.calculateTotalValue() // This should include cash balance
+	var targets []*strategypb.StockSignal
 	for _, signal := range signals {
-		if signal.Signal == strategypb.SignalType_BUY {
-			allocation := (signal.RiskUnit / totalRiskUnits) * totalValue
-			quantity := int32(allocation / signal.CurrentPrice)
+		if _, kept := desiredPortfolio[signal.Symbol]; kept {
+			continue
+		}
+		pos, held := current.positions[signal.Symbol]
+		switch {
+		case signal.Signal == strategypb.SignalType_HOLD && held:
 			desiredPortfolio[signal.Symbol] = &pb.Position{
-				Symbol:       signal.Symbol,
-				Quantity:     quantity,
-				CurrentPrice: signal.CurrentPrice,
-				MarketValue:  float64(quantity) * signal.CurrentPrice,
+				Symbol:       pos.Symbol,
+				Quantity:     pos.Quantity,
+				CurrentPrice: pos.CurrentPrice,
+				MarketValue:  pos.MarketValue,
 			}
+			totalValue -= pos.MarketValue
+		case isTargetSignal(signal):
+			targets = append(targets, signal)
+		}
+	}
+	if len(targets) == 0 || totalValue <= 0 {
+		return desiredPortfolio, nil
+	}
+
+	weights, err := p.targetWeights(ctx, p.configuration().Optimizer, targets)
+	if err != nil {
+		return nil, err
+	}
+	for _, signal := range targets {
+		allocation := weights[signal.Symbol] * totalValue
+		quantity := int32(allocation / signal.CurrentPrice)
+		if quantity <= 0 {
+			continue
+		}
+		desiredPortfolio[signal.Symbol] = &pb.Position{
+			Symbol:       signal.Symbol,
+			Quantity:     quantity,
+			CurrentPrice: signal.CurrentPrice,
+			MarketValue:  float64(quantity) * signal.CurrentPrice,
 		}
 	}
 
 	return desiredPortfolio, nil
 }
 
-func (s *Server) generateOrders(currentPortfolio, desiredPortfolio map[string]*pb.Position) []*pb.Order {
+// isTargetSignal reports whether a signal is sized into the desired portfolio. Only BUY signals
+// are; signals without a price cannot be sized and are left out.
+func isTargetSignal(signal *strategypb.StockSignal) bool {
+	return signal.Signal == strategypb.SignalType_BUY && signal.CurrentPrice > 0
+}
+
+// generateOrders returns the market orders that turn the current portfolio into the desired one,
+// in symbol order. Quantities are positive for buys and negative for sells, and the price is the
+// reference price the quantity was sized at.
+func (p *Portfolio) generateOrders(currentPortfolio, desiredPortfolio map[string]*pb.Position) []*pb.Order {
 	var orders []*pb.Order
 
-	for symbol, desiredPos := range desiredPortfolio {
-		currentPos, exists := currentPortfolio[symbol]
-		if !exists {
-			// New position to open
+	for _, symbol := range unionSymbols(currentPortfolio, desiredPortfolio) {
+		currentQuantity, desiredQuantity, price := positionChange(currentPortfolio, desiredPortfolio, symbol)
+		if orderQuantity := desiredQuantity - currentQuantity; orderQuantity != 0 {
 			orders = append(orders, &pb.Order{
 				Symbol:   symbol,
 				Type:     pb.OrderType_MARKET,
-				Quantity: desiredPos.Quantity,
+				Quantity: orderQuantity,
+				Price:    price,
 			})
-		} else if desiredPos.Quantity != currentPos.Quantity {
-			// Existing position to adjust
-			orderQuantity := desiredPos.Quantity - currentPos.Quantity
-			orderType := pb.OrderType_MARKET
-			if orderQuantity > 0 {
-				orders = append(orders, &pb.Order{
-					Symbol:   symbol,
-					Type:     orderType,
-					Quantity: orderQuantity,
-				})
-			} else {
-				orders = append(orders, &pb.Order{
-					Symbol:   symbol,
-					Type:     orderType,
-					Quantity: -orderQuantity,
-				})
-			}
 		}
 	}
 
-	// Check for positions to close
-	for symbol, currentPos := range currentPortfolio {
-		if _, exists := desiredPortfolio[symbol]; !exists {
-			orders = append(orders, &pb.Order{
-				Symbol:   symbol,
-				Type:     pb.OrderType_MARKET,
-				Quantity: -currentPos.Quantity,
-			})
+	return orders
+}
+
+// positionChange returns the current and target quantities of a symbol and its price, taken from
+// the target when the symbol is in both.
+func positionChange(current, target map[string]*pb.Position, symbol string) (currentQuantity, targetQuantity int32, price float64) {
+	if pos, held := current[symbol]; held {
+		currentQuantity, price = pos.Quantity, pos.CurrentPrice
+	}
+	if pos, wanted := target[symbol]; wanted {
+		targetQuantity, price = pos.Quantity, pos.CurrentPrice
+	}
+	return currentQuantity, targetQuantity, price
+}
+
+// unionSymbols returns the symbols of both portfolios, sorted.
+func unionSymbols(a, b map[string]*pb.Position) []string {
+	symbols := make([]string, 0, len(a)+len(b))
+	for symbol := range a {
+		symbols = append(symbols, symbol)
+	}
+	for symbol := range b {
+		if _, ok := a[symbol]; !ok {
+			symbols = append(symbols, symbol)
 		}
 	}
+	sort.Strings(symbols)
+	return symbols
+}
 
-	return orders
+func toTradeOrders(orders []*pb.Order) []*tradepb.Order {
+	tradeOrders := make([]*tradepb.Order, len(orders))
+	for i, order := range orders {
+		tradeOrders[i] = &tradepb.Order{
+			Symbol:   order.Symbol,
+			Type:     tradepb.OrderType(order.Type),
+			Quantity: order.Quantity,
+			Price:    order.Price,
+		}
+	}
+	return tradeOrders
 }
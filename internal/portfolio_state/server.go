@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 
 	pb "momentum-trading-platform/api/proto/portfolio_state_service"
+	"momentum-trading-platform/internal/utils"
 
 	"github.com/lib/pq"
 	_ "github.com/lib/pq"
@@ -18,11 +19,15 @@ import (
 const createTableSQL = `
 	CREATE TABLE IF NOT EXISTS portfolio_state (
 		id SERIAL PRIMARY KEY,
+		portfolio_id TEXT NOT NULL DEFAULT 'default',
 		positions JSONB,
 		cash_balance FLOAT,
 		total_value FLOAT,
 		timestamp TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-);`
+);
+	-- States stored before accounts were kept apart belong to the default portfolio
+	ALTER TABLE portfolio_state ADD COLUMN IF NOT EXISTS portfolio_id TEXT NOT NULL DEFAULT 'default';
+	CREATE INDEX IF NOT EXISTS portfolio_state_portfolio_id_idx ON portfolio_state (portfolio_id, timestamp DESC);`
 
 type Server struct {
 	pb.UnimplementedPortfolioStateServiceServer
@@ -60,17 +65,23 @@ func (s *Server) initDB() error {
 }
 
 func (s *Server) GetPortfolioState(ctx context.Context, req *pb.GetPortfolioStateRequest) (*pb.PortfolioState, error) {
-	s.Logger.Info("Getting portfolio state")
+	portfolioID, err := utils.PortfolioID(req.PortfolioId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	s.Logger.WithField("portfolioId", portfolioID).Info("Getting portfolio state")
 
 	var positionsJSON []byte
 	var cashBalance, totalValue float64
-	err := s.DB.QueryRow("SELECT positions, cash_balance, total_value FROM portfolio_state ORDER BY timestamp DESC LIMIT 1").Scan(&positionsJSON, &cashBalance, &totalValue)
+	err = s.DB.QueryRow("SELECT positions, cash_balance, total_value FROM portfolio_state WHERE portfolio_id = $1 ORDER BY timestamp DESC LIMIT 1", portfolioID).
+		Scan(&positionsJSON, &cashBalance, &totalValue)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return &pb.PortfolioState{
 				Positions:   make([]*pb.Position, 0),
 				CashBalance: 1000000, // Default starting cash
 				TotalValue:  1000000,
+				PortfolioId: portfolioID,
 			}, nil
 		}
 		return nil, err
@@ -85,11 +96,15 @@ func (s *Server) GetPortfolioState(ctx context.Context, req *pb.GetPortfolioStat
 		Positions:   positions,
 		CashBalance: cashBalance,
 		TotalValue:  totalValue,
+		PortfolioId: portfolioID,
 	}, nil
 }
 
 func (s *Server) UpdatePortfolioState(ctx context.Context, req *pb.UpdatePortfolioStateRequest) (*pb.UpdatePortfolioStateResponse, error) {
-	s.Logger.Info("Updating portfolio state")
+	portfolioID, err := utils.PortfolioID(req.PortfolioId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	positionsJSON, err := json.Marshal(req.Positions)
 	if err != nil {
@@ -103,13 +118,14 @@ func (s *Server) UpdatePortfolioState(ctx context.Context, req *pb.UpdatePortfol
 	}
 
 	s.Logger.WithFields(log.Fields{
+		"portfolioId":   portfolioID,
 		"positionCount": len(req.Positions),
 		"cashBalance":   req.CashBalance,
 		"totalValue":    totalValue,
 	}).Info("Updating portfolio state")
 
-	_, err = s.DB.Exec("INSERT INTO portfolio_state (positions, cash_balance, total_value) VALUES ($1, $2, $3)",
-		positionsJSON, req.CashBalance, totalValue)
+	_, err = s.DB.Exec("INSERT INTO portfolio_state (portfolio_id, positions, cash_balance, total_value) VALUES ($1, $2, $3, $4)",
+		portfolioID, positionsJSON, req.CashBalance, totalValue)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
@@ -0,0 +1,144 @@
+package strategy
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"hash/maphash"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+	pb "momentum-trading-platform/api/proto/strategy_service"
+)
+
+const (
+	signalCacheSize = 32
+	signalCacheTTL  = 15 * time.Minute
+)
+
+// fingerprintSeed seeds the hash of the market data. Cache keys only live in this process, so a
+// per-process seed is enough, and maphash hashes every bar several times faster than fnv.
+var fingerprintSeed = maphash.MakeSeed()
+
+// signalCache keeps recent strategy responses keyed on the strategy, its parameters and a
+// fingerprint of the market data, so that repeated requests over unchanged data skip scoring the
+// universe again. Cached responses are shared and must not be modified.
+type signalCache struct {
+	mu      sync.Mutex
+	entries map[string]signalCacheEntry
+	order   []string // Keys from oldest to newest, for eviction
+}
+
+type signalCacheEntry struct {
+	strategyName string
+	response     *pb.SignalResponse
+	expires      time.Time
+}
+
+func newSignalCache() *signalCache {
+	return &signalCache{entries: make(map[string]signalCacheEntry)}
+}
+
+// signalCacheKey identifies a strategy run. The data version hashes the closes and adjusted
+// closes of every bar of every symbol, so it changes whenever the data service returns new prices
+// or revises old ones, for example after a split or dividend adjustment.
+func signalCacheKey(strategyName string, params map[string]interface{}, batchStockData map[string]*datapb.StockResponse, indexData *datapb.StockResponse) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	h := fnv.New64a()
+	for _, name := range names {
+		fmt.Fprintf(h, "%s=%v;", name, params[name])
+	}
+	parameterVersion := h.Sum64()
+
+	symbols := make([]string, 0, len(batchStockData))
+	for symbol := range batchStockData {
+		symbols = append(symbols, symbol)
+	}
+	slices.Sort(symbols)
+	// Symbols are hashed concurrently like they are scored and combined in symbol order
+	fingerprints := make([]uint64, len(symbols))
+	forEachParallel(len(symbols), func(i int) {
+		fingerprints[i] = fingerprint(symbols[i], batchStockData[symbols[i]])
+	})
+	if indexData != nil {
+		fingerprints = append(fingerprints, fingerprint(indexData.Symbol, indexData))
+	}
+	var data maphash.Hash
+	data.SetSeed(fingerprintSeed)
+	var buf [8]byte
+	for _, f := range fingerprints {
+		binary.LittleEndian.PutUint64(buf[:], f)
+		data.Write(buf[:])
+	}
+	return fmt.Sprintf("%s/%016x/%016x", strategyName, parameterVersion, data.Sum64())
+}
+
+// fingerprint hashes every field of every bar of a symbol, since the filters and risk methods read
+// the open, high, low and volume as well as the closes.
+func fingerprint(symbol string, stockResp *datapb.StockResponse) uint64 {
+	var h maphash.Hash
+	h.SetSeed(fingerprintSeed)
+	h.WriteString(symbol)
+	var buf [56]byte
+	binary.LittleEndian.PutUint64(buf[:8], uint64(len(stockResp.DataPoints)))
+	h.Write(buf[:8])
+	for _, dp := range stockResp.DataPoints {
+		binary.LittleEndian.PutUint64(buf[0:], uint64(dp.Timestamp))
+		binary.LittleEndian.PutUint64(buf[8:], math.Float64bits(dp.Open))
+		binary.LittleEndian.PutUint64(buf[16:], math.Float64bits(dp.High))
+		binary.LittleEndian.PutUint64(buf[24:], math.Float64bits(dp.Low))
+		binary.LittleEndian.PutUint64(buf[32:], math.Float64bits(dp.Close))
+		binary.LittleEndian.PutUint64(buf[40:], math.Float64bits(dp.AdjustedClose))
+		binary.LittleEndian.PutUint64(buf[48:], uint64(dp.Volume))
+		h.Write(buf[:])
+	}
+	return h.Sum64()
+}
+
+func (c *signalCache) get(key string) (*pb.SignalResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.response, true
+}
+
+func (c *signalCache) put(key, strategyName string, resp *pb.SignalResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.entries[key]; !exists {
+		c.order = append(c.order, key)
+	}
+	c.entries[key] = signalCacheEntry{strategyName: strategyName, response: resp, expires: time.Now().Add(signalCacheTTL)}
+	for len(c.order) > signalCacheSize {
+		delete(c.entries, c.order[0])
+		c.order = c.order[1:]
+	}
+}
+
+// invalidate drops the entries of a strategy whose configuration changed.
+func (c *signalCache) invalidate(strategyName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	kept := c.order[:0]
+	for _, key := range c.order {
+		if c.entries[key].strategyName == strategyName {
+			delete(c.entries, key)
+			continue
+		}
+		kept = append(kept, key)
+	}
+	c.order = kept
+}
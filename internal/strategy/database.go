@@ -0,0 +1,165 @@
+package strategy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/strategy_service"
+
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const createTableSQL = `
+CREATE TABLE IF NOT EXISTS signal_runs (
+    run_id UUID PRIMARY KEY,
+    strategy_name TEXT NOT NULL,
+    parameters JSONB,
+    symbols TEXT[],
+    signal_symbols TEXT[],
+    start_date TEXT,
+    end_date TEXT,
+    interval TEXT,
+    market_index TEXT,
+    response JSONB,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS signal_runs_created_at_idx ON signal_runs (created_at);`
+
+const defaultSignalRunLimit = 100
+
+func (s *Server) initDatabase() error {
+	_, err := s.DB.Exec(createTableSQL)
+	return err
+}
+
+func (s *Server) storeSignalRun(run *pb.SignalRun) error {
+	parametersJSON, err := json.Marshal(run.Parameters)
+	if err != nil {
+		return fmt.Errorf("failed to marshal parameters: %w", err)
+	}
+	responseJSON, err := protojson.Marshal(run.Response)
+	if err != nil {
+		return fmt.Errorf("failed to marshal signal response: %w", err)
+	}
+
+	signalSymbols := make([]string, 0, len(run.Response.Signals))
+	for _, signal := range run.Response.Signals {
+		signalSymbols = append(signalSymbols, signal.Symbol)
+	}
+
+	query := `INSERT INTO signal_runs (run_id, strategy_name, parameters, symbols, signal_symbols, start_date, end_date, interval, market_index, response, created_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	createdAt, err := time.Parse(time.RFC3339, run.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("invalid run timestamp: %w", err)
+	}
+
+	_, err = s.DB.Exec(query, run.RunId, run.StrategyName, parametersJSON, pq.Array(run.Symbols), pq.Array(signalSymbols),
+		run.StartDate, run.EndDate, run.Interval, run.MarketIndex, responseJSON, createdAt)
+	return err
+}
+
+func (s *Server) listSignalRuns(req *pb.ListSignalRunsRequest) ([]*pb.SignalRun, error) {
+	var conditions []string
+	var args []interface{}
+
+	if req.StrategyName != "" {
+		args = append(args, req.StrategyName)
+		conditions = append(conditions, fmt.Sprintf("strategy_name = $%d", len(args)))
+	}
+	if req.Symbol != "" {
+		args = append(args, req.Symbol)
+		conditions = append(conditions, fmt.Sprintf("$%d = ANY(signal_symbols)", len(args)))
+	}
+	if req.FromDate != "" {
+		from, err := time.Parse("2006-01-02", req.FromDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from_date: %w", err)
+		}
+		args = append(args, from)
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if req.ToDate != "" {
+		to, err := time.Parse("2006-01-02", req.ToDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to_date: %w", err)
+		}
+		args = append(args, to.AddDate(0, 0, 1))
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSignalRunLimit
+	}
+	args = append(args, limit)
+
+	query := `SELECT run_id, strategy_name, parameters, symbols, start_date, end_date, interval, market_index, response, created_at
+              FROM signal_runs`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d", len(args))
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var runs []*pb.SignalRun
+	for rows.Next() {
+		run, err := scanSignalRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, rows.Err()
+}
+
+func (s *Server) getSignalRun(runID string) (*pb.SignalRun, error) {
+	query := `SELECT run_id, strategy_name, parameters, symbols, start_date, end_date, interval, market_index, response, created_at
+              FROM signal_runs
+              WHERE run_id = $1`
+
+	return scanSignalRun(s.DB.QueryRow(query, runID))
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSignalRun(row rowScanner) (*pb.SignalRun, error) {
+	var run pb.SignalRun
+	var parametersJSON, responseJSON []byte
+	var symbols pq.StringArray
+	var createdAt time.Time
+
+	err := row.Scan(&run.RunId, &run.StrategyName, &parametersJSON, &symbols, &run.StartDate, &run.EndDate,
+		&run.Interval, &run.MarketIndex, &responseJSON, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parametersJSON) > 0 {
+		if err := json.Unmarshal(parametersJSON, &run.Parameters); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal parameters: %w", err)
+		}
+	}
+	run.Response = &pb.SignalResponse{}
+	if len(responseJSON) > 0 {
+		if err := protojson.Unmarshal(responseJSON, run.Response); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal signal response: %w", err)
+		}
+	}
+
+	run.Symbols = symbols
+	run.CreatedAt = createdAt.UTC().Format(time.RFC3339)
+	return &run, nil
+}
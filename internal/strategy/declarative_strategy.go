@@ -0,0 +1,475 @@
+package strategy
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+	pb "momentum-trading-platform/api/proto/strategy_service"
+	"momentum-trading-platform/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// StrategyDefinition is the YAML description of a declarative strategy: which stocks qualify,
+// how they are ranked, how many are selected and how positions are sized.
+type StrategyDefinition struct {
+	Name        string              `yaml:"name"`
+	Description string              `yaml:"description"`
+	Universe    UniverseDefinition  `yaml:"universe"`
+	Ranking     RankingDefinition   `yaml:"ranking"`
+	Selection   SelectionDefinition `yaml:"selection"`
+	Risk        RiskDefinition      `yaml:"risk"`
+	Regime      RegimeDefinition    `yaml:"regime"`
+}
+
+// UniverseDefinition configures the default symbols and the filter pipeline. Filters are the
+// names accepted by NewFilterPipeline.
+type UniverseDefinition struct {
+	Symbols            []string `yaml:"symbols"`
+	Filters            []string `yaml:"filters"`
+	MinHistory         int      `yaml:"minHistory"`
+	GapPeriod          int      `yaml:"gapPeriod"`
+	MaxGap             float64  `yaml:"maxGap"`
+	MATrendPeriod      int      `yaml:"maTrendPeriod"`
+	MinPrice           float64  `yaml:"minPrice"`
+	MinDollarVolume    float64  `yaml:"minDollarVolume"`
+	DollarVolumePeriod int      `yaml:"dollarVolumePeriod"`
+	ExcludedSymbols    []string `yaml:"excludedSymbols"`
+}
+
+// RankingDefinition holds the factor expression stocks are ranked by.
+type RankingDefinition struct {
+	Factor string `yaml:"factor"`
+	Order  string `yaml:"order"` // "descending" (default) or "ascending"
+}
+
+// SelectionDefinition limits how many ranked stocks are selected. When both are set the smaller count wins.
+type SelectionDefinition struct {
+	TopN       int     `yaml:"topN"`
+	TopPercent float64 `yaml:"topPercent"`
+}
+
+// RiskDefinition selects the risk unit method. Unset fields take the values of DefaultRiskConfig.
+type RiskDefinition struct {
+	Method           string  `yaml:"method"` // any RiskConfig method; "volatility" means inverseVolatility
+	Period           int     `yaml:"period"`
+	RiskFactor       float64 `yaml:"riskFactor"`
+	TargetVolatility float64 `yaml:"targetVolatility"`
+	RiskFraction     float64 `yaml:"riskFraction"`
+	StopMultiple     float64 `yaml:"stopMultiple"`
+}
+
+// RegimeDefinition selects the regime model and the action taken in each regime. Unset fields
+// take the values of DefaultRegimeConfig.
+type RegimeDefinition struct {
+	Model           string   `yaml:"model"`
+	Models          []string `yaml:"models"` // Components of the composite model
+	Period          int      `yaml:"period"`
+	Bull            string   `yaml:"bull"`
+	Neutral         string   `yaml:"neutral"`
+	Bear            string   `yaml:"bear"`
+	ReducedExposure float64  `yaml:"reducedExposure"`
+}
+
+const (
+	rankingDescending = "descending"
+	rankingAscending  = "ascending"
+
+	// riskMethodVolatility is accepted in definitions as a short name for inverseVolatility.
+	riskMethodVolatility = "volatility"
+)
+
+// ParseStrategyDefinition decodes a YAML strategy definition, fills in defaults and validates it.
+// Unknown keys are rejected so that typos do not silently fall back to defaults.
+func ParseStrategyDefinition(data []byte) (*StrategyDefinition, error) {
+	var def StrategyDefinition
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&def); err != nil {
+		return nil, fmt.Errorf("invalid strategy definition: %v", err)
+	}
+	def.applyDefaults()
+	if err := def.validate(); err != nil {
+		return nil, fmt.Errorf("invalid strategy definition %q: %v", def.Name, err)
+	}
+	return &def, nil
+}
+
+func (d *StrategyDefinition) applyDefaults() {
+	defaults := DefaultFilterConfig()
+	if d.Universe.MaxGap == 0 {
+		d.Universe.MaxGap = defaults.MaxGap
+	}
+	if d.Universe.MATrendPeriod == 0 {
+		d.Universe.MATrendPeriod = defaults.MATrendPeriod
+	}
+	if d.Universe.DollarVolumePeriod == 0 {
+		d.Universe.DollarVolumePeriod = defaults.DollarVolumePeriod
+	}
+	for i, symbol := range d.Universe.Symbols {
+		d.Universe.Symbols[i] = strings.ToUpper(strings.TrimSpace(symbol))
+	}
+	if d.Ranking.Order == "" {
+		d.Ranking.Order = rankingDescending
+	}
+	risk := DefaultRiskConfig()
+	if d.Risk.Method == "" {
+		d.Risk.Method = risk.Method
+	}
+	if d.Risk.Method == riskMethodVolatility {
+		d.Risk.Method = riskMethodInverseVolatility
+	}
+	if d.Risk.Period == 0 {
+		d.Risk.Period = risk.Period
+	}
+	if d.Risk.RiskFactor == 0 {
+		d.Risk.RiskFactor = risk.RiskFactor
+	}
+	if d.Risk.TargetVolatility == 0 {
+		d.Risk.TargetVolatility = risk.TargetVolatility
+	}
+	if d.Risk.RiskFraction == 0 {
+		d.Risk.RiskFraction = risk.RiskFraction
+	}
+	if d.Risk.StopMultiple == 0 {
+		d.Risk.StopMultiple = risk.StopMultiple
+	}
+	regime := DefaultRegimeConfig()
+	if d.Regime.Model == "" {
+		d.Regime.Model = regime.Model
+	}
+	if len(d.Regime.Models) == 0 {
+		d.Regime.Models = regime.Components
+	}
+	if d.Regime.Period == 0 {
+		d.Regime.Period = regime.MAPeriod
+	}
+	if d.Regime.Bull == "" {
+		d.Regime.Bull = string(regime.BullAction)
+	}
+	if d.Regime.Neutral == "" {
+		d.Regime.Neutral = string(regime.NeutralAction)
+	}
+	if d.Regime.Bear == "" {
+		d.Regime.Bear = string(regime.BearAction)
+	}
+	if d.Regime.ReducedExposure == 0 {
+		d.Regime.ReducedExposure = regime.ReducedExposure
+	}
+}
+
+func (d *StrategyDefinition) regimeConfig() RegimeConfig {
+	return RegimeConfig{
+		Model:           d.Regime.Model,
+		Components:      d.Regime.Models,
+		MAPeriod:        d.Regime.Period,
+		BullAction:      RegimeAction(d.Regime.Bull),
+		NeutralAction:   RegimeAction(d.Regime.Neutral),
+		BearAction:      RegimeAction(d.Regime.Bear),
+		ReducedExposure: d.Regime.ReducedExposure,
+	}
+}
+
+func (d *StrategyDefinition) riskConfig() RiskConfig {
+	return RiskConfig{
+		Method:           d.Risk.Method,
+		Period:           d.Risk.Period,
+		RiskFactor:       d.Risk.RiskFactor,
+		TargetVolatility: d.Risk.TargetVolatility,
+		RiskFraction:     d.Risk.RiskFraction,
+		StopMultiple:     d.Risk.StopMultiple,
+	}
+}
+
+func (d *StrategyDefinition) validate() error {
+	if strings.TrimSpace(d.Name) == "" || strings.ContainsAny(d.Name, " .:,") {
+		return fmt.Errorf("name must be set and must not contain spaces, dots, colons or commas")
+	}
+	if _, err := ParseFactorExpression(d.Ranking.Factor); err != nil {
+		return fmt.Errorf("ranking factor: %v", err)
+	}
+	if d.Ranking.Order != rankingDescending && d.Ranking.Order != rankingAscending {
+		return fmt.Errorf("ranking order must be %q or %q, got %q", rankingDescending, rankingAscending, d.Ranking.Order)
+	}
+	if d.Selection.TopN < 0 || d.Selection.TopPercent < 0 || d.Selection.TopPercent > 1 {
+		return fmt.Errorf("selection topN must be non-negative and topPercent in [0, 1]")
+	}
+	if d.Selection.TopN == 0 && d.Selection.TopPercent == 0 {
+		return fmt.Errorf("selection needs topN or topPercent")
+	}
+	if err := d.riskConfig().validate(); err != nil {
+		return fmt.Errorf("risk: %v", err)
+	}
+	regime := d.regimeConfig()
+	if err := regime.validate(); err != nil {
+		return fmt.Errorf("regime: %v", err)
+	}
+	if _, err := regime.NewRegimeModel(); err != nil {
+		return fmt.Errorf("regime: %v", err)
+	}
+	return nil
+}
+
+func (d *StrategyDefinition) filterConfig() FilterConfig {
+	return FilterConfig{
+		GapPeriod:          d.Universe.GapPeriod,
+		MaxGap:             d.Universe.MaxGap,
+		MATrendPeriod:      d.Universe.MATrendPeriod,
+		MinPrice:           d.Universe.MinPrice,
+		MinDollarVolume:    d.Universe.MinDollarVolume,
+		DollarVolumePeriod: d.Universe.DollarVolumePeriod,
+		MinHistory:         d.Universe.MinHistory,
+		ExcludedSymbols:    d.Universe.ExcludedSymbols,
+	}
+}
+
+// DeclarativeStrategy runs a StrategyDefinition. Stocks that pass the universe filters are
+// ranked by the factor expression and the top of the ranking is selected.
+type DeclarativeStrategy struct {
+	definition   StrategyDefinition
+	factor       *FactorExpression
+	filters      FilterPipeline
+	riskConfig   RiskConfig
+	regimeConfig RegimeConfig
+	regimeModel  RegimeModel
+}
+
+// NewDeclarativeStrategy compiles a definition into a strategy.
+func NewDeclarativeStrategy(def *StrategyDefinition) (*DeclarativeStrategy, error) {
+	if err := def.validate(); err != nil {
+		return nil, err
+	}
+	factor, err := ParseFactorExpression(def.Ranking.Factor)
+	if err != nil {
+		return nil, err
+	}
+	// The factor lookback stands in for the lookback period of the built-in strategies
+	filters, err := NewFilterPipeline(def.Universe.Filters, def.filterConfig(), max(factor.Lookback(), def.Risk.Period+1))
+	if err != nil {
+		return nil, err
+	}
+	regimeConfig := def.regimeConfig()
+	regimeModel, err := regimeConfig.NewRegimeModel()
+	if err != nil {
+		return nil, err
+	}
+	return &DeclarativeStrategy{
+		definition:   *def,
+		factor:       factor,
+		filters:      filters,
+		riskConfig:   def.riskConfig(),
+		regimeConfig: regimeConfig,
+		regimeModel:  regimeModel,
+	}, nil
+}
+
+// Definition returns a copy of the strategy definition.
+func (s *DeclarativeStrategy) Definition() StrategyDefinition {
+	return s.definition
+}
+
+// DefaultUniverse returns the symbols listed in the definition, if any.
+func (s *DeclarativeStrategy) DefaultUniverse() []string {
+	return s.definition.Universe.Symbols
+}
+
+func (s *DeclarativeStrategy) GenerateSignals(batchStockData map[string]*datapb.StockResponse, indexData *datapb.StockResponse) (*pb.SignalResponse, error) {
+	assessment := s.DetectMarketRegime(indexData, batchStockData)
+	regime := assessment.Regime
+
+	var signals []*pb.StockSignal
+	diagnostics := make(map[string]*pb.SignalDiagnostic, len(batchStockData))
+
+	for symbol, data := range batchStockData {
+		signal, diagnostic := s.generateSignal(symbol, data)
+		diagnostic.MarketRegime = pb.MarketRegime(regime)
+		diagnostics[symbol] = diagnostic
+		if signal != nil {
+			signals = append(signals, signal)
+		}
+	}
+
+	ascending := s.definition.Ranking.Order == rankingAscending
+	sort.Slice(signals, func(i, j int) bool {
+		if signals[i].MomentumScore != signals[j].MomentumScore {
+			return (signals[i].MomentumScore < signals[j].MomentumScore) == ascending
+		}
+		return signals[i].Symbol < signals[j].Symbol
+	})
+	for i, signal := range signals {
+		diagnostics[signal.Symbol].Rank = int32(i + 1)
+	}
+
+	selected := signals[:s.selectionCount(len(signals))]
+	log.Infof("🔍 %s: found %d stocks, selecting top %d by %s", s.definition.Name, len(signals), len(selected), s.factor)
+	action := s.regimeConfig.Action(regime)
+	selected = applyRegimeAction(selected, action)
+	s.riskConfig.allocate(selected, batchStockData)
+	for _, signal := range selected {
+		diagnostics[signal.Symbol].Selected = true
+	}
+
+	return setRegime(&pb.SignalResponse{
+		Signals:     selected,
+		Diagnostics: sortDiagnostics(diagnostics),
+	}, assessment, action, s.regimeConfig.Exposure(action)), nil
+}
+
+// selectionCount applies topN and topPercent. A topPercent selection keeps at least one stock.
+func (s *DeclarativeStrategy) selectionCount(candidates int) int {
+	count := candidates
+	if s.definition.Selection.TopPercent > 0 {
+		count = max(int(float64(candidates)*s.definition.Selection.TopPercent), 1)
+	}
+	if s.definition.Selection.TopN > 0 {
+		count = min(count, s.definition.Selection.TopN)
+	}
+	return min(count, candidates)
+}
+
+func (s *DeclarativeStrategy) generateSignal(symbol string, stockResp *datapb.StockResponse) (*pb.StockSignal, *pb.SignalDiagnostic) {
+	diagnostic := &pb.SignalDiagnostic{Symbol: symbol}
+	filterResults, passed := s.filters.Evaluate(stockResp)
+	diagnostic.Filters = filterResults
+	if len(stockResp.DataPoints) == 0 {
+		return nil, diagnostic
+	}
+
+	dataPoints := stockResp.DataPoints
+	lastPrice := dataPoints[len(dataPoints)-1].Close
+	score := s.factor.Evaluate(dataPoints)
+	diagnostic.Atr = utils.CalculateATR(dataPoints, s.definition.Risk.Period)
+	if !math.IsNaN(score) {
+		diagnostic.MomentumScore = score
+	}
+
+	factorResult := pass("factor")
+	if math.IsNaN(score) {
+		factorResult = fail("factor", ReasonUndefinedFactor,
+			fmt.Sprintf("%s is undefined with %d data points, need %d", s.factor, len(dataPoints), s.factor.Lookback()))
+		passed = false
+	}
+	diagnostic.Filters = append(diagnostic.Filters, factorResult)
+	if !passed {
+		return nil, diagnostic
+	}
+
+	signal := &pb.StockSignal{
+		Symbol:        symbol,
+		Signal:        pb.SignalType_BUY,
+		MomentumScore: score,
+		CurrentPrice:  lastPrice,
+	}
+	s.riskConfig.applyRisk(signal, dataPoints)
+	return signal, diagnostic
+}
+
+func (s *DeclarativeStrategy) CalculateRisk(stockData *datapb.StockResponse) float64 {
+	riskUnit, _ := s.riskConfig.riskUnit(stockData.DataPoints)
+	return riskUnit
+}
+
+func (s *DeclarativeStrategy) DetectMarketRegime(indexData *datapb.StockResponse, universe map[string]*datapb.StockResponse) RegimeAssessment {
+	return s.regimeModel.Assess(indexData, universe)
+}
+
+func (s *DeclarativeStrategy) ParameterSchema() []ParameterSpec {
+	return []ParameterSpec{
+		{Name: "definition", Type: StringParameter, Default: "", Description: "Complete YAML definition replacing the current one"},
+		{Name: "factor", Type: StringParameter, Default: s.definition.Ranking.Factor, Description: "Ranking factor expression"},
+		{Name: "topN", Type: IntParameter, Default: s.definition.Selection.TopN, Min: 0, Max: 500, Description: "Maximum number of stocks selected, 0 for no limit"},
+		{Name: "topPercent", Type: FloatParameter, Default: s.definition.Selection.TopPercent, Min: 0, Max: 1, Description: "Fraction of ranked stocks selected, 0 for no limit"},
+		{Name: "riskMethod", Type: StringParameter, Default: s.definition.Risk.Method, Description: "atr, atrPercent, inverseVolatility, equalRiskContribution, fixedFractional or equal"},
+		{Name: "riskFactor", Type: FloatParameter, Default: s.definition.Risk.RiskFactor, Min: 0.0001, Max: 0.01, Description: "Risk factor divided by ATR or ATR percent to size positions"},
+	}
+}
+
+func (s *DeclarativeStrategy) GetParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"factor":     s.definition.Ranking.Factor,
+		"topN":       s.definition.Selection.TopN,
+		"topPercent": s.definition.Selection.TopPercent,
+		"riskMethod": s.definition.Risk.Method,
+		"riskFactor": s.definition.Risk.RiskFactor,
+	}
+}
+
+// SetParameters replaces the definition when a "definition" parameter is given and then applies
+// the individual overrides. The definition must keep the strategy name.
+func (s *DeclarativeStrategy) SetParameters(params map[string]interface{}) error {
+	def := s.definition
+	def.Universe.Symbols = append([]string(nil), def.Universe.Symbols...)
+
+	r := newParamReader(params)
+	var source string
+	r.String("definition", &source)
+	if err := r.Err(); err != nil {
+		return err
+	}
+	if source != "" {
+		parsed, err := ParseStrategyDefinition([]byte(source))
+		if err != nil {
+			return err
+		}
+		if parsed.Name != s.definition.Name {
+			return fmt.Errorf("definition name %q does not match strategy %q", parsed.Name, s.definition.Name)
+		}
+		def = *parsed
+	}
+
+	r.String("factor", &def.Ranking.Factor)
+	r.Int("topN", &def.Selection.TopN)
+	r.Float("topPercent", &def.Selection.TopPercent)
+	r.String("riskMethod", &def.Risk.Method)
+	r.Float("riskFactor", &def.Risk.RiskFactor)
+	if err := r.Err(); err != nil {
+		return err
+	}
+	if def.Risk.Method == riskMethodVolatility {
+		def.Risk.Method = riskMethodInverseVolatility
+	}
+
+	next, err := NewDeclarativeStrategy(&def)
+	if err != nil {
+		return err
+	}
+	*s = *next
+	return nil
+}
+
+// LoadStrategyDefinitions parses every .yaml and .yml file in dir and registers the definitions
+// so that they can be selected by name. It returns the registered names.
+func LoadStrategyDefinitions(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read strategy directory %s: %v", dir, err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return names, fmt.Errorf("failed to read %s: %v", entry.Name(), err)
+		}
+		def, err := ParseStrategyDefinition(data)
+		if err != nil {
+			return names, fmt.Errorf("%s: %v", entry.Name(), err)
+		}
+		if err := RegisterStrategyDefinition(def); err != nil {
+			return names, fmt.Errorf("%s: %v", entry.Name(), err)
+		}
+		names = append(names, def.Name)
+	}
+	return names, nil
+}
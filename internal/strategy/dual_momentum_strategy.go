@@ -0,0 +1,280 @@
+package strategy
+
+import (
+	"fmt"
+	"strings"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+	pb "momentum-trading-platform/api/proto/strategy_service"
+	"momentum-trading-platform/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DualMomentumStrategy combines relative momentum (cross-sectional rank) with absolute momentum
+// measured against a cash proxy. Selected stocks whose momentum does not beat cash, or the whole
+// book in a bear market, rotate into a defensive asset.
+type DualMomentumStrategy struct {
+	lookbackPeriod int
+	topPercentage  float64
+	risk           RiskConfig
+	regimeConfig   RegimeConfig // Only the model is used; a bear market rotates into the defensive asset
+	regimeModel    RegimeModel
+	cashProxy      string // e.g. a T-bill ETF
+	defensiveAsset string // e.g. an aggregate bond ETF
+	filterNames    []string
+	filterConfig   FilterConfig
+	filters        FilterPipeline
+}
+
+var defaultDualMomentumFilters = []string{"minHistory"}
+
+func NewDualMomentumStrategy() *DualMomentumStrategy {
+	s := &DualMomentumStrategy{
+		lookbackPeriod: 252,
+		topPercentage:  0.2,
+		risk:           DefaultRiskConfig(),
+		regimeConfig:   DefaultRegimeConfig(),
+		cashProxy:      "BIL",
+		defensiveAsset: "AGG",
+		filterNames:    defaultDualMomentumFilters,
+		filterConfig:   DefaultFilterConfig(),
+	}
+	s.regimeModel, _ = s.regimeConfig.NewRegimeModel()
+	s.filters, _ = NewFilterPipeline(s.filterNames, s.filterConfig, s.lookbackPeriod)
+	return s
+}
+
+// RequiredSymbols returns the cash proxy and defensive asset, which are fetched alongside the universe.
+func (s *DualMomentumStrategy) RequiredSymbols() []string {
+	return []string{s.cashProxy, s.defensiveAsset}
+}
+
+// GenerateSignals ranks the universe by momentum, keeps the top names whose momentum beats the
+// cash proxy, and allocates the remaining risk budget to the defensive asset.
+func (s *DualMomentumStrategy) GenerateSignals(batchStockData map[string]*datapb.StockResponse, indexData *datapb.StockResponse) (*pb.SignalResponse, error) {
+	assessment := s.DetectMarketRegime(indexData, batchStockData)
+	regime := assessment.Regime
+
+	cashMomentum := 0.0
+	if cashData, ok := batchStockData[s.cashProxy]; ok {
+		cashMomentum = utils.CalculateMomentumScore(cashData.DataPoints, s.lookbackPeriod)
+	} else {
+		log.Warnf("❗ No data for cash proxy %s, using zero as the absolute momentum hurdle", s.cashProxy)
+	}
+
+	var candidates []*pb.StockSignal
+	diagnostics := make(map[string]*pb.SignalDiagnostic, len(batchStockData))
+
+	for symbol, data := range batchStockData {
+		if symbol == s.cashProxy || symbol == s.defensiveAsset {
+			continue
+		}
+		signal, diagnostic := s.generateSignal(symbol, data)
+		diagnostic.MarketRegime = pb.MarketRegime(regime)
+		diagnostics[symbol] = diagnostic
+		if signal != nil {
+			candidates = append(candidates, signal)
+		}
+	}
+
+	rankByMomentum(candidates)
+	for i, signal := range candidates {
+		diagnostics[signal.Symbol].Rank = int32(i + 1)
+	}
+
+	topCount := int(float64(len(candidates)) * s.topPercentage)
+	if topCount == 0 && len(candidates) > 0 {
+		topCount = 1
+	}
+	log.Infof("🔍 Found %d stocks, selecting top %d based on relative momentum", len(candidates), topCount)
+
+	var signals []*pb.StockSignal
+	defensiveRiskUnits := 0.0
+	for _, signal := range candidates[:topCount] {
+		diagnostic := diagnostics[signal.Symbol]
+		absoluteMomentum := signal.MomentumScore - cashMomentum
+		result := pass("absoluteMomentum")
+		if absoluteMomentum <= 0 {
+			result = fail("absoluteMomentum", ReasonBelowCashMomentum,
+				fmt.Sprintf("momentum %.4f does not beat %s momentum %.4f", signal.MomentumScore, s.cashProxy, cashMomentum))
+		}
+		diagnostic.Filters = append(diagnostic.Filters, result)
+
+		if regime == Bear || absoluteMomentum <= 0 {
+			defensiveRiskUnits += signal.RiskUnit
+			continue
+		}
+		diagnostic.Selected = true
+		signals = append(signals, signal)
+	}
+
+	s.risk.allocate(signals, batchStockData)
+	if len(signals) == 0 || defensiveRiskUnits > 0 {
+		if defensive := s.defensiveSignal(batchStockData, defensiveRiskUnits, len(signals) == 0); defensive != nil {
+			log.Infof("🛡️ Rotating into defensive asset %s", s.defensiveAsset)
+			signals = append(signals, defensive)
+		}
+	}
+
+	return setRegime(&pb.SignalResponse{
+		Signals:     signals,
+		Diagnostics: sortDiagnostics(diagnostics),
+	}, assessment, RegimeFull, 1), nil
+}
+
+// defensiveSignal builds the BUY signal for the defensive asset. When other names are held it
+// takes over the risk units of the names that failed the absolute momentum check so that it
+// receives their share of the portfolio; otherwise it holds the whole book.
+func (s *DualMomentumStrategy) defensiveSignal(batchStockData map[string]*datapb.StockResponse, riskUnits float64, wholeBook bool) *pb.StockSignal {
+	data, ok := batchStockData[s.defensiveAsset]
+	if !ok || len(data.DataPoints) == 0 {
+		log.Warnf("❗ No data for defensive asset %s, leaving its allocation in cash", s.defensiveAsset)
+		return nil
+	}
+
+	signal := &pb.StockSignal{
+		Symbol:        s.defensiveAsset,
+		Signal:        pb.SignalType_BUY,
+		MomentumScore: utils.CalculateMomentumScore(data.DataPoints, s.lookbackPeriod),
+		CurrentPrice:  data.DataPoints[len(data.DataPoints)-1].Close,
+	}
+	s.risk.applyRisk(signal, data.DataPoints)
+	if !wholeBook && riskUnits > 0 {
+		signal.RiskUnit = riskUnits
+	}
+	return signal
+}
+
+// generateSignal scores a stock and applies the filter pipeline. The returned signal is nil when the stock is disqualified.
+func (s *DualMomentumStrategy) generateSignal(symbol string, stockResp *datapb.StockResponse) (*pb.StockSignal, *pb.SignalDiagnostic) {
+	diagnostic := &pb.SignalDiagnostic{Symbol: symbol}
+	filterResults, passed := s.filters.Evaluate(stockResp)
+	diagnostic.Filters = filterResults
+	if len(stockResp.DataPoints) == 0 {
+		return nil, diagnostic
+	}
+
+	lastPrice := stockResp.DataPoints[len(stockResp.DataPoints)-1].Close
+	slope, r2 := utils.CalculateMomentumRegression(stockResp.DataPoints, s.lookbackPeriod)
+	diagnostic.MomentumSlope = slope
+	diagnostic.RSquared = r2
+	diagnostic.MomentumScore = slope * r2
+	diagnostic.Atr = utils.CalculateATR(stockResp.DataPoints, s.risk.Period)
+	if !passed {
+		return nil, diagnostic
+	}
+
+	signal := &pb.StockSignal{
+		Symbol:        symbol,
+		Signal:        pb.SignalType_BUY,
+		MomentumScore: diagnostic.MomentumScore,
+		CurrentPrice:  lastPrice,
+	}
+	s.risk.applyRisk(signal, stockResp.DataPoints)
+	return signal, diagnostic
+}
+
+func (s *DualMomentumStrategy) CalculateRisk(stockData *datapb.StockResponse) float64 {
+	riskUnit, _ := s.risk.riskUnit(stockData.DataPoints)
+	return riskUnit
+}
+
+func (s *DualMomentumStrategy) DetectMarketRegime(indexData *datapb.StockResponse, universe map[string]*datapb.StockResponse) RegimeAssessment {
+	return s.regimeModel.Assess(indexData, universe)
+}
+
+func (s *DualMomentumStrategy) ParameterSchema() []ParameterSpec {
+	return append([]ParameterSpec{
+		{Name: "lookbackPeriod", Type: IntParameter, Default: 252, Min: 20, Max: 504, Description: "Days used for relative and absolute momentum"},
+		{Name: "topPercentage", Type: FloatParameter, Default: 0.2, Min: 0.01, Max: 1, Description: "Fraction of qualified stocks ranked for selection"},
+		{Name: "cashProxy", Type: StringParameter, Default: "BIL", Description: "Series used as the absolute momentum hurdle"},
+		{Name: "defensiveAsset", Type: StringParameter, Default: "AGG", Description: "Asset held when absolute momentum is negative"},
+		{Name: "filters", Type: ListParameter, Default: strings.Join(defaultDualMomentumFilters, ","), Description: "Disqualification filters in evaluation order"},
+		{Name: "minPrice", Type: FloatParameter, Default: 0.0, Description: "Minimum last close"},
+		{Name: "minDollarVolume", Type: FloatParameter, Default: 0.0, Description: "Minimum average daily dollar volume"},
+		{Name: "minHistory", Type: IntParameter, Default: 0, Min: 0, Max: 1000, Description: "Minimum data points, 0 derives it from the periods"},
+		{Name: "excludedSymbols", Type: ListParameter, Default: "", Description: "Symbols that are never selected"},
+	}, append(riskParameterSchema(DefaultRiskConfig(), "riskPeriod"), regimeModelParameterSchema(DefaultRegimeConfig())...)...)
+}
+
+func (s *DualMomentumStrategy) GetParameters() map[string]interface{} {
+	return regimeModelParameters(s.regimeConfig, riskParameters(s.risk, "riskPeriod", map[string]interface{}{
+		"lookbackPeriod":  s.lookbackPeriod,
+		"topPercentage":   s.topPercentage,
+		"cashProxy":       s.cashProxy,
+		"defensiveAsset":  s.defensiveAsset,
+		"filters":         strings.Join(s.filterNames, ","),
+		"minPrice":        s.filterConfig.MinPrice,
+		"minDollarVolume": s.filterConfig.MinDollarVolume,
+		"minHistory":      s.filterConfig.MinHistory,
+		"excludedSymbols": strings.Join(s.filterConfig.ExcludedSymbols, ","),
+	}))
+}
+
+func (s *DualMomentumStrategy) SetParameters(params map[string]interface{}) error {
+	lookbackPeriod := s.lookbackPeriod
+	topPercentage := s.topPercentage
+	risk := s.risk
+	regimeConfig := s.regimeConfig
+	cashProxy := s.cashProxy
+	defensiveAsset := s.defensiveAsset
+	filterNames := s.filterNames
+	filterConfig := s.filterConfig
+
+	r := newParamReader(params)
+	r.Int("lookbackPeriod", &lookbackPeriod)
+	r.Float("topPercentage", &topPercentage)
+	readRiskParameters(r, &risk, "riskPeriod")
+	readRegimeModelParameters(r, &regimeConfig)
+	r.String("cashProxy", &cashProxy)
+	r.String("defensiveAsset", &defensiveAsset)
+	r.List("filters", &filterNames)
+	r.Float("minPrice", &filterConfig.MinPrice)
+	r.Float("minDollarVolume", &filterConfig.MinDollarVolume)
+	r.Int("minHistory", &filterConfig.MinHistory)
+	r.List("excludedSymbols", &filterConfig.ExcludedSymbols)
+	if err := r.Err(); err != nil {
+		return err
+	}
+	cashProxy = strings.ToUpper(cashProxy)
+	defensiveAsset = strings.ToUpper(defensiveAsset)
+
+	if lookbackPeriod <= 0 {
+		return fmt.Errorf("lookbackPeriod must be positive")
+	}
+	if topPercentage <= 0 || topPercentage > 1 {
+		return fmt.Errorf("topPercentage must be in (0, 1], got %v", topPercentage)
+	}
+	if cashProxy == "" || defensiveAsset == "" {
+		return fmt.Errorf("cashProxy and defensiveAsset must be set")
+	}
+	if err := risk.validate(); err != nil {
+		return err
+	}
+
+	if err := regimeConfig.validate(); err != nil {
+		return err
+	}
+	regimeModel, err := regimeConfig.NewRegimeModel()
+	if err != nil {
+		return err
+	}
+
+	filters, err := NewFilterPipeline(filterNames, filterConfig, lookbackPeriod)
+	if err != nil {
+		return err
+	}
+
+	s.lookbackPeriod = lookbackPeriod
+	s.topPercentage = topPercentage
+	s.risk = risk
+	s.regimeConfig = regimeConfig
+	s.regimeModel = regimeModel
+	s.cashProxy = cashProxy
+	s.defensiveAsset = defensiveAsset
+	s.filterNames = filterNames
+	s.filterConfig = filterConfig
+	s.filters = filters
+	return nil
+}
@@ -0,0 +1,487 @@
+package strategy
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+	pb "momentum-trading-platform/api/proto/strategy_service"
+	"momentum-trading-platform/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ensembleModeWeighted = "weighted"
+	ensembleModeVote     = "vote"
+
+	// minNormalizedScore is the normalized score of a child's weakest BUY
+	minNormalizedScore = 0.1
+)
+
+// ensembleMember is a child strategy and its blending weight.
+type ensembleMember struct {
+	name     string
+	weight   float64
+	strategy Strategy
+}
+
+// EnsembleStrategy runs several child strategies and blends their signals into one ranking.
+// Each child's BUY scores are min-max normalized before blending so that strategies with different
+// score scales contribute comparably. In weighted mode any BUY qualifies a stock, which is ranked
+// by the weighted average of normalized scores of children that want to buy; in vote mode a stock
+// needs minVotes BUY signals and is ranked by vote count. Risk units are blended the same way after
+// each child's are scaled to sum to 1, so that the weights decide the allocation whatever risk
+// method a child uses. Child parameters are configured with a "<child>." prefix.
+type EnsembleStrategy struct {
+	members  []ensembleMember
+	mode     string
+	topN     int // 0 selects every stock that qualifies
+	minVotes int
+	metadata MetadataProvider
+}
+
+func NewEnsembleStrategy() *EnsembleStrategy {
+	return &EnsembleStrategy{
+		members: []ensembleMember{
+			{name: "momentum", weight: 0.5, strategy: NewMomentumStrategy()},
+			{name: "dualMomentum", weight: 0.5, strategy: NewDualMomentumStrategy()},
+		},
+		mode:     ensembleModeWeighted,
+		topN:     0,
+		minVotes: 2,
+	}
+}
+
+// RequiredSymbols returns the extra symbols needed by any child strategy.
+func (s *EnsembleStrategy) RequiredSymbols() []string {
+	var symbols []string
+	for _, member := range s.members {
+		if provider, ok := member.strategy.(SymbolProvider); ok {
+			symbols = mergeSymbols(symbols, provider.RequiredSymbols())
+		}
+	}
+	return symbols
+}
+
+// DefaultUniverse returns the union of the child strategies' fixed universes.
+func (s *EnsembleStrategy) DefaultUniverse() []string {
+	var symbols []string
+	for _, member := range s.members {
+		if provider, ok := member.strategy.(UniverseProvider); ok {
+			symbols = mergeSymbols(symbols, provider.DefaultUniverse())
+		}
+	}
+	return symbols
+}
+
+// blendedSignal accumulates the contributions of every child to one symbol.
+type blendedSignal struct {
+	symbol       string
+	price        float64
+	score        float64
+	votes        int
+	riskUnit     float64
+	anyRiskUnit  float64 // Sum of every child's scaled risk unit, the fallback for a stock no child buys
+	riskMethod   string  // The children's common risk method, or blended when they differ
+	held         bool    // At least one child wants to keep an existing position
+	attributions []*pb.SignalAttribution
+}
+
+func (b *blendedSignal) addRiskMethod(method string) {
+	switch b.riskMethod {
+	case "":
+		b.riskMethod = method
+	case method:
+	default:
+		b.riskMethod = riskMethodBlended
+	}
+}
+
+func (s *EnsembleStrategy) GenerateSignals(batchStockData map[string]*datapb.StockResponse, indexData *datapb.StockResponse) (*pb.SignalResponse, error) {
+	blended := make(map[string]*blendedSignal)
+	var assessment *RegimeAssessment
+	action := RegimeFull
+	exposure := 0.0
+	totalWeight := 0.0
+
+	auxiliary := s.RequiredSymbols()
+	for _, member := range s.members {
+		resp, err := member.strategy.GenerateSignals(memberData(member.strategy, batchStockData, auxiliary), indexData)
+		if err != nil {
+			return nil, fmt.Errorf("strategy %s failed: %w", member.name, err)
+		}
+		child := &RegimeAssessment{Regime: MarketRegime(resp.MarketRegime), Confidence: resp.RegimeConfidence, Signals: resp.RegimeSignals}
+		if assessment == nil || moreConservativeRegime(assessment.Regime, child.Regime) != assessment.Regime {
+			assessment, action = child, regimeActionFromProto(resp.RegimeAction)
+		}
+		// Each child's regime action has already been applied to its signals, so the ensemble
+		// only blends the exposures the children ask for
+		exposure += member.weight * utils.TargetExposure(resp)
+		totalWeight += member.weight
+
+		normalized := normalizeScores(resp.Signals)
+		riskUnits := scaleRiskUnits(resp.Signals)
+		for i, signal := range resp.Signals {
+			b, ok := blended[signal.Symbol]
+			if !ok {
+				b = &blendedSignal{symbol: signal.Symbol, price: signal.CurrentPrice}
+				blended[signal.Symbol] = b
+			}
+			b.attributions = append(b.attributions, &pb.SignalAttribution{
+				StrategyName:    member.name,
+				Signal:          signal.Signal,
+				Score:           signal.MomentumScore,
+				NormalizedScore: normalized[i],
+				Weight:          member.weight,
+				RiskUnit:        signal.RiskUnit,
+			})
+			b.anyRiskUnit += riskUnits[i]
+			switch signal.Signal {
+			case pb.SignalType_BUY:
+				b.votes++
+				b.score += member.weight * normalized[i]
+				b.riskUnit += member.weight * riskUnits[i]
+				b.addRiskMethod(signal.RiskMethod)
+			case pb.SignalType_HOLD:
+				b.held = true
+			}
+		}
+	}
+
+	if totalWeight > 0 {
+		exposure /= totalWeight
+	}
+	regime := assessment.Regime
+
+	var candidates []*blendedSignal
+	for _, b := range blended {
+		if totalWeight > 0 {
+			// A stock only some children buy gets only their share of the allocation
+			b.riskUnit /= totalWeight
+			b.score /= totalWeight
+		}
+		if s.mode == ensembleModeVote {
+			// Votes rank first; the blended score breaks ties between equal vote counts
+			b.score = float64(b.votes) + b.score
+		}
+		candidates = append(candidates, b)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].symbol < candidates[j].symbol
+	})
+
+	var signals []*pb.StockSignal
+	diagnostics := make(map[string]*pb.SignalDiagnostic, len(candidates))
+	selectedCount := 0
+	for i, b := range candidates {
+		diagnostic := &pb.SignalDiagnostic{
+			Symbol:        b.symbol,
+			MomentumScore: b.score,
+			MarketRegime:  pb.MarketRegime(regime),
+			Filters:       attributionFilters(b.attributions),
+		}
+		diagnostics[b.symbol] = diagnostic
+		if b.votes > 0 {
+			diagnostic.Rank = int32(i + 1)
+		}
+
+		signal := &pb.StockSignal{
+			Symbol:        b.symbol,
+			Signal:        pb.SignalType_HOLD,
+			RiskUnit:      b.riskUnit,
+			RiskMethod:    b.riskMethod,
+			MomentumScore: b.score,
+			CurrentPrice:  b.price,
+			Attributions:  b.attributions,
+		}
+		if s.qualifies(b) && (s.topN == 0 || selectedCount < s.topN) {
+			signal.Signal = pb.SignalType_BUY
+			diagnostic.Selected = true
+			selectedCount++
+		} else if !b.held && b.votes == 0 {
+			continue
+		}
+		if signal.RiskUnit == 0 {
+			signal.RiskUnit = b.anyRiskUnit / float64(len(b.attributions))
+		}
+		signals = append(signals, signal)
+	}
+	log.Infof("🔍 Ensemble of %d strategies selected %d of %d stocks", len(s.members), selectedCount, len(candidates))
+
+	return setRegime(&pb.SignalResponse{
+		Signals:     signals,
+		Diagnostics: sortDiagnostics(diagnostics),
+	}, *assessment, action, exposure), nil
+}
+
+// memberData hides the auxiliary series fetched for other children (such as a cash proxy) from a
+// child that did not ask for them, so that they are not ranked as part of its universe.
+func memberData(strategy Strategy, batchStockData map[string]*datapb.StockResponse, auxiliary []string) map[string]*datapb.StockResponse {
+	own := make(map[string]bool)
+	if provider, ok := strategy.(SymbolProvider); ok {
+		for _, symbol := range provider.RequiredSymbols() {
+			own[symbol] = true
+		}
+	}
+
+	data := make(map[string]*datapb.StockResponse, len(batchStockData))
+	for symbol, stockData := range batchStockData {
+		data[symbol] = stockData
+	}
+	for _, symbol := range auxiliary {
+		if !own[symbol] {
+			delete(data, symbol)
+		}
+	}
+	return data
+}
+
+func (s *EnsembleStrategy) qualifies(b *blendedSignal) bool {
+	if s.mode == ensembleModeVote {
+		return b.votes >= s.minVotes
+	}
+	return b.votes > 0
+}
+
+// normalizeScores min-max scales the scores of one child's BUY signals to [minNormalizedScore, 1],
+// so that its weakest BUY still adds to the blend. A single BUY, or BUYs with identical scores,
+// all normalize to 1. Other signals normalize to 0.
+func normalizeScores(signals []*pb.StockSignal) []float64 {
+	normalized := make([]float64, len(signals))
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, signal := range signals {
+		if signal.Signal == pb.SignalType_BUY {
+			low = math.Min(low, signal.MomentumScore)
+			high = math.Max(high, signal.MomentumScore)
+		}
+	}
+	for i, signal := range signals {
+		switch {
+		case signal.Signal != pb.SignalType_BUY:
+			normalized[i] = 0
+		case high > low:
+			normalized[i] = minNormalizedScore + (1-minNormalizedScore)*(signal.MomentumScore-low)/(high-low)
+		default:
+			normalized[i] = 1
+		}
+	}
+	return normalized
+}
+
+// attributionFilters reports each child's vote as a filter result in the diagnostics.
+func attributionFilters(attributions []*pb.SignalAttribution) []*pb.FilterResult {
+	results := make([]*pb.FilterResult, 0, len(attributions))
+	for _, attribution := range attributions {
+		result := pass(attribution.StrategyName)
+		if attribution.Signal != pb.SignalType_BUY {
+			result.Passed = false
+			result.Reason = fmt.Sprintf("%s signal from %s", attribution.Signal, attribution.StrategyName)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// scaleRiskUnits scales one child's risk units so that those of its BUY signals sum to 1. Risk
+// methods size positions on different scales (per-share ATR units are far smaller than inverse
+// volatility units), so without this the child with the larger scale would decide the blended
+// allocation whatever its weight. A child without BUYs is scaled by the sum of all its units.
+func scaleRiskUnits(signals []*pb.StockSignal) []float64 {
+	buyTotal, total := 0.0, 0.0
+	for _, signal := range signals {
+		if signal.Signal == pb.SignalType_BUY {
+			buyTotal += signal.RiskUnit
+		}
+		total += signal.RiskUnit
+	}
+	if buyTotal > 0 {
+		total = buyTotal
+	}
+
+	scaled := make([]float64, len(signals))
+	if total <= 0 {
+		return scaled
+	}
+	for i, signal := range signals {
+		scaled[i] = signal.RiskUnit / total
+	}
+	return scaled
+}
+
+// moreConservativeRegime returns the more defensive of two regimes (Bear, then Neutral, then Bull).
+func moreConservativeRegime(a, b MarketRegime) MarketRegime {
+	rank := map[MarketRegime]int{Bull: 0, Neutral: 1, Bear: 2}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
+
+// CalculateRisk returns the weighted average of the child strategies' risk units.
+func (s *EnsembleStrategy) CalculateRisk(stockData *datapb.StockResponse) float64 {
+	total, totalWeight := 0.0, 0.0
+	for _, member := range s.members {
+		total += member.weight * member.strategy.CalculateRisk(stockData)
+		totalWeight += member.weight
+	}
+	if totalWeight == 0 {
+		return 0
+	}
+	return total / totalWeight
+}
+
+// DetectMarketRegime returns the most conservative assessment of any child.
+func (s *EnsembleStrategy) DetectMarketRegime(indexData *datapb.StockResponse, universe map[string]*datapb.StockResponse) RegimeAssessment {
+	assessment := RegimeAssessment{Regime: Neutral}
+	for i, member := range s.members {
+		child := member.strategy.DetectMarketRegime(indexData, universe)
+		if i == 0 || moreConservativeRegime(assessment.Regime, child.Regime) != assessment.Regime {
+			assessment = child
+		}
+	}
+	return assessment
+}
+
+func (s *EnsembleStrategy) ParameterSchema() []ParameterSpec {
+	return []ParameterSpec{
+		{Name: "strategies", Type: ListParameter, Default: "momentum:0.5,dualMomentum:0.5", Description: "Child strategies as name:weight; child parameters use a <name>. prefix"},
+		{Name: "mode", Type: StringParameter, Default: ensembleModeWeighted, Description: "weighted or vote"},
+		{Name: "topN", Type: IntParameter, Default: 0, Min: 0, Max: 500, Description: "Number of stocks to select, 0 selects all qualifying stocks"},
+		{Name: "minVotes", Type: IntParameter, Default: 2, Min: 1, Max: 10, Description: "BUY votes needed in vote mode"},
+	}
+}
+
+func (s *EnsembleStrategy) GetParameters() map[string]interface{} {
+	members := make([]string, len(s.members))
+	params := map[string]interface{}{
+		"mode":     s.mode,
+		"topN":     s.topN,
+		"minVotes": s.minVotes,
+	}
+	for i, member := range s.members {
+		members[i] = fmt.Sprintf("%s:%v", member.name, member.weight)
+		for k, v := range member.strategy.GetParameters() {
+			params[member.name+"."+k] = v
+		}
+	}
+	params["strategies"] = strings.Join(members, ",")
+	return params
+}
+
+func (s *EnsembleStrategy) SetParameters(params map[string]interface{}) error {
+	mode := s.mode
+	topN := s.topN
+	minVotes := s.minVotes
+	var memberSpecs []string
+
+	r := newParamReader(params)
+	r.String("mode", &mode)
+	r.Int("topN", &topN)
+	r.Int("minVotes", &minVotes)
+	r.List("strategies", &memberSpecs)
+	if err := r.Err(); err != nil {
+		return err
+	}
+
+	if mode != ensembleModeWeighted && mode != ensembleModeVote {
+		return fmt.Errorf("mode must be %q or %q, got %q", ensembleModeWeighted, ensembleModeVote, mode)
+	}
+	if topN < 0 || minVotes < 1 {
+		return fmt.Errorf("topN must not be negative and minVotes must be positive")
+	}
+
+	members, err := s.buildMembers(memberSpecs)
+	if err != nil {
+		return err
+	}
+
+	// Route prefixed parameters to the matching child
+	childParams := make(map[string]map[string]interface{})
+	for k, v := range params {
+		name, key, ok := strings.Cut(k, ".")
+		if !ok {
+			continue
+		}
+		if childParams[name] == nil {
+			childParams[name] = make(map[string]interface{})
+		}
+		childParams[name][key] = v
+	}
+	for _, member := range members {
+		if p, ok := childParams[member.name]; ok {
+			if err := member.strategy.SetParameters(p); err != nil {
+				return fmt.Errorf("failed to set parameters for %s: %w", member.name, err)
+			}
+			delete(childParams, member.name)
+		}
+	}
+	for name := range childParams {
+		return fmt.Errorf("parameters given for %s, which is not part of the ensemble", name)
+	}
+
+	s.members = members
+	s.mode = mode
+	s.topN = topN
+	s.minVotes = minVotes
+	return nil
+}
+
+// SetMetadataProvider passes the provider on to every child that uses one.
+func (s *EnsembleStrategy) SetMetadataProvider(provider MetadataProvider) {
+	s.metadata = provider
+	for _, member := range s.members {
+		setMetadataProvider(member.strategy, provider)
+	}
+}
+
+// buildMembers creates fresh child strategies carrying over the parameters of existing children,
+// so that a failed update leaves the ensemble unchanged. An empty spec keeps the current children.
+func (s *EnsembleStrategy) buildMembers(specs []string) ([]ensembleMember, error) {
+	if len(specs) == 0 {
+		for _, member := range s.members {
+			specs = append(specs, fmt.Sprintf("%s:%v", member.name, member.weight))
+		}
+	}
+
+	current := make(map[string]Strategy, len(s.members))
+	for _, member := range s.members {
+		current[member.name] = member.strategy
+	}
+
+	members := make([]ensembleMember, 0, len(specs))
+	for _, spec := range specs {
+		name, weightStr, hasWeight := strings.Cut(spec, ":")
+		name = strings.TrimSpace(name)
+		weight := 1.0
+		if hasWeight {
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(weightStr), 64)
+			if err != nil || parsed < 0 {
+				return nil, fmt.Errorf("invalid weight %q for strategy %s", weightStr, name)
+			}
+			weight = parsed
+		}
+		if name == "ensemble" {
+			return nil, fmt.Errorf("an ensemble cannot contain another ensemble")
+		}
+
+		child, err := NewStrategy(name)
+		if err != nil {
+			return nil, err
+		}
+		setMetadataProvider(child, s.metadata)
+		if existing, ok := current[name]; ok {
+			if err := child.SetParameters(existing.GetParameters()); err != nil {
+				return nil, fmt.Errorf("failed to carry over parameters for %s: %w", name, err)
+			}
+		}
+		members = append(members, ensembleMember{name: name, weight: weight, strategy: child})
+	}
+	return members, nil
+}
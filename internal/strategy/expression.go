@@ -0,0 +1,433 @@
+package strategy
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+	"momentum-trading-platform/internal/utils"
+)
+
+// FactorExpression is a compiled ranking factor such as "momentum(90) / volatility(60)". It is
+// evaluated against the latest bar of a stock; the result is NaN when the stock does not have
+// enough history for one of the indicators.
+type FactorExpression struct {
+	source string
+	root   exprNode
+}
+
+// factorFunction describes an indicator that can be used in a factor expression.
+type factorFunction struct {
+	args     int // number of arguments; -1 for indicators that take no parentheses
+	periods  int // leading arguments that must be positive integer periods
+	evaluate func(series *factorSeries, args []float64) float64
+	lookback func(args []float64) int
+}
+
+// factorFunctions lists the indicators available to factor expressions. Prices are closes
+// except for momentum and volatility, which use adjusted closes like the built-in strategies.
+var factorFunctions = map[string]factorFunction{
+	"close": {
+		args:     -1,
+		evaluate: func(s *factorSeries, _ []float64) float64 { return utils.Last(s.closes()) },
+		lookback: func([]float64) int { return 1 },
+	},
+	"momentum": {
+		args: 1, periods: 1,
+		evaluate: func(s *factorSeries, a []float64) float64 {
+			if len(s.dataPoints) < int(a[0]) {
+				return math.NaN()
+			}
+			return utils.CalculateMomentumScore(s.dataPoints, int(a[0]))
+		},
+		lookback: func(a []float64) int { return int(a[0]) },
+	},
+	"roc": {
+		args: 1, periods: 1,
+		evaluate: func(s *factorSeries, a []float64) float64 { return utils.Last(utils.ROC(s.closes(), int(a[0]))) },
+		lookback: func(a []float64) int { return int(a[0]) + 1 },
+	},
+	"sma": {
+		args: 1, periods: 1,
+		evaluate: func(s *factorSeries, a []float64) float64 { return utils.Last(utils.SMA(s.closes(), int(a[0]))) },
+		lookback: func(a []float64) int { return int(a[0]) },
+	},
+	"ema": {
+		args: 1, periods: 1,
+		evaluate: func(s *factorSeries, a []float64) float64 { return utils.Last(utils.EMA(s.closes(), int(a[0]))) },
+		lookback: func(a []float64) int { return int(a[0]) },
+	},
+	"rsi": {
+		args: 1, periods: 1,
+		evaluate: func(s *factorSeries, a []float64) float64 { return utils.Last(utils.RSI(s.closes(), int(a[0]))) },
+		lookback: func(a []float64) int { return int(a[0]) + 1 },
+	},
+	"atr": {
+		args: 1, periods: 1,
+		evaluate: func(s *factorSeries, a []float64) float64 {
+			return utils.Last(utils.WilderATR(s.highs(), s.lows(), s.closes(), int(a[0])))
+		},
+		lookback: func(a []float64) int { return int(a[0]) + 1 },
+	},
+	"adx": {
+		args: 1, periods: 1,
+		evaluate: func(s *factorSeries, a []float64) float64 {
+			adx, _, _ := utils.ADX(s.highs(), s.lows(), s.closes(), int(a[0]))
+			return utils.Last(adx)
+		},
+		lookback: func(a []float64) int { return 2 * int(a[0]) },
+	},
+	"volatility": {
+		args: 1, periods: 1,
+		evaluate: func(s *factorSeries, a []float64) float64 {
+			return utils.Last(utils.RollingVolatility(s.adjustedCloses(), int(a[0])))
+		},
+		lookback: func(a []float64) int { return int(a[0]) + 1 },
+	},
+	"macd": {
+		args: 3, periods: 3,
+		evaluate: func(s *factorSeries, a []float64) float64 {
+			_, _, histogram := utils.MACD(s.closes(), int(a[0]), int(a[1]), int(a[2]))
+			return utils.Last(histogram)
+		},
+		lookback: func(a []float64) int { return int(a[1]) + int(a[2]) - 1 },
+	},
+	"highest": {
+		args: 1, periods: 1,
+		evaluate: func(s *factorSeries, a []float64) float64 {
+			upper, _ := utils.DonchianChannels(s.highs(), s.lows(), int(a[0]))
+			return utils.Last(upper)
+		},
+		lookback: func(a []float64) int { return int(a[0]) },
+	},
+	"lowest": {
+		args: 1, periods: 1,
+		evaluate: func(s *factorSeries, a []float64) float64 {
+			_, lower := utils.DonchianChannels(s.highs(), s.lows(), int(a[0]))
+			return utils.Last(lower)
+		},
+		lookback: func(a []float64) int { return int(a[0]) },
+	},
+	"abs": {
+		args:     1,
+		evaluate: func(_ *factorSeries, a []float64) float64 { return math.Abs(a[0]) },
+	},
+	"min": {
+		args:     2,
+		evaluate: func(_ *factorSeries, a []float64) float64 { return math.Min(a[0], a[1]) },
+	},
+	"max": {
+		args:     2,
+		evaluate: func(_ *factorSeries, a []float64) float64 { return math.Max(a[0], a[1]) },
+	},
+}
+
+// factorSeries lazily extracts the price series of a stock so that each is built at most once per evaluation.
+type factorSeries struct {
+	dataPoints []*datapb.StockDataPoint
+	cache      map[string][]float64
+}
+
+func (s *factorSeries) series(name string, extract func([]*datapb.StockDataPoint) []float64) []float64 {
+	if values, ok := s.cache[name]; ok {
+		return values
+	}
+	values := extract(s.dataPoints)
+	s.cache[name] = values
+	return values
+}
+
+func (s *factorSeries) closes() []float64 { return s.series("close", utils.Closes) }
+func (s *factorSeries) highs() []float64  { return s.series("high", utils.Highs) }
+func (s *factorSeries) lows() []float64   { return s.series("low", utils.Lows) }
+func (s *factorSeries) adjustedCloses() []float64 {
+	return s.series("adjustedClose", utils.AdjustedCloses)
+}
+
+// ParseFactorExpression compiles a factor expression. Expressions combine numbers and the
+// indicators in factorFunctions with + - * / and parentheses.
+func ParseFactorExpression(source string) (*FactorExpression, error) {
+	p := &exprParser{input: source}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("factor expression is empty")
+	}
+	root, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.tokens[p.pos].text, p.tokens[p.pos].offset)
+	}
+	return &FactorExpression{source: source, root: root}, nil
+}
+
+// String returns the expression source.
+func (e *FactorExpression) String() string {
+	return e.source
+}
+
+// Evaluate computes the factor on the latest bar of the data points.
+func (e *FactorExpression) Evaluate(dataPoints []*datapb.StockDataPoint) float64 {
+	value := e.root.eval(&factorSeries{dataPoints: dataPoints, cache: make(map[string][]float64)})
+	if math.IsInf(value, 0) {
+		return math.NaN()
+	}
+	return value
+}
+
+// Lookback returns the number of data points needed for every indicator in the expression.
+func (e *FactorExpression) Lookback() int {
+	return e.root.lookback()
+}
+
+type exprNode interface {
+	eval(series *factorSeries) float64
+	lookback() int
+}
+
+type numberNode float64
+
+func (n numberNode) eval(*factorSeries) float64 { return float64(n) }
+func (n numberNode) lookback() int              { return 0 }
+
+type negateNode struct{ operand exprNode }
+
+func (n negateNode) eval(s *factorSeries) float64 { return -n.operand.eval(s) }
+func (n negateNode) lookback() int                { return n.operand.lookback() }
+
+type binaryNode struct {
+	op          byte
+	left, right exprNode
+}
+
+func (n binaryNode) eval(s *factorSeries) float64 {
+	left, right := n.left.eval(s), n.right.eval(s)
+	switch n.op {
+	case '+':
+		return left + right
+	case '-':
+		return left - right
+	case '*':
+		return left * right
+	default:
+		if right == 0 {
+			return math.NaN()
+		}
+		return left / right
+	}
+}
+
+func (n binaryNode) lookback() int { return max(n.left.lookback(), n.right.lookback()) }
+
+type callNode struct {
+	function factorFunction
+	args     []exprNode
+}
+
+func (n callNode) eval(s *factorSeries) float64 {
+	args := make([]float64, len(n.args))
+	for i, arg := range n.args {
+		args[i] = arg.eval(s)
+	}
+	return n.function.evaluate(s, args)
+}
+
+func (n callNode) lookback() int {
+	lookback := 0
+	for _, arg := range n.args {
+		lookback = max(lookback, arg.lookback())
+	}
+	if n.function.lookback != nil {
+		// Periods are checked to be numbers when the expression is parsed
+		periods := make([]float64, n.function.periods)
+		for i := range periods {
+			periods[i] = float64(n.args[i].(numberNode))
+		}
+		lookback = max(lookback, n.function.lookback(periods))
+	}
+	return lookback
+}
+
+type exprToken struct {
+	text   string
+	offset int
+}
+
+type exprParser struct {
+	input  string
+	tokens []exprToken
+	pos    int
+}
+
+func (p *exprParser) tokenize() error {
+	for i := 0; i < len(p.input); {
+		c := rune(p.input[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case strings.ContainsRune("+-*/(),", c):
+			p.tokens = append(p.tokens, exprToken{text: string(c), offset: i})
+			i++
+		case unicode.IsDigit(c) || c == '.':
+			start := i
+			for i < len(p.input) && (unicode.IsDigit(rune(p.input[i])) || p.input[i] == '.') {
+				i++
+			}
+			p.tokens = append(p.tokens, exprToken{text: p.input[start:i], offset: start})
+		case unicode.IsLetter(c):
+			start := i
+			for i < len(p.input) && (unicode.IsLetter(rune(p.input[i])) || unicode.IsDigit(rune(p.input[i]))) {
+				i++
+			}
+			p.tokens = append(p.tokens, exprToken{text: p.input[start:i], offset: start})
+		default:
+			return fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+	return nil
+}
+
+func (p *exprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos].text
+	}
+	return ""
+}
+
+func (p *exprParser) expect(text string) error {
+	if p.peek() != text {
+		if p.pos >= len(p.tokens) {
+			return fmt.Errorf("expected %q at end of expression", text)
+		}
+		return fmt.Errorf("expected %q at position %d, got %q", text, p.tokens[p.pos].offset, p.peek())
+	}
+	p.pos++
+	return nil
+}
+
+// parseExpr parses additions and subtractions.
+func (p *exprParser) parseExpr() (exprNode, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "+" || p.peek() == "-" {
+		op := p.peek()[0]
+		p.pos++
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseTerm parses multiplications and divisions.
+func (p *exprParser) parseTerm() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "*" || p.peek() == "/" {
+		op := p.peek()[0]
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.peek() == "-" {
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return negateNode{operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+
+	switch {
+	case token.text == "(":
+		node, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		return node, p.expect(")")
+	case unicode.IsDigit(rune(token.text[0])) || token.text[0] == '.':
+		value, err := strconv.ParseFloat(token.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", token.text, token.offset)
+		}
+		return numberNode(value), nil
+	case unicode.IsLetter(rune(token.text[0])):
+		return p.parseCall(token)
+	default:
+		return nil, fmt.Errorf("unexpected %q at position %d", token.text, token.offset)
+	}
+}
+
+// parseCall parses an indicator reference and checks its arguments.
+func (p *exprParser) parseCall(token exprToken) (exprNode, error) {
+	name := strings.ToLower(token.text)
+	function, ok := factorFunctions[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at position %d", token.text, token.offset)
+	}
+	if function.args < 0 {
+		return callNode{function: function}, nil
+	}
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var args []exprNode
+	for p.peek() != ")" {
+		if len(args) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	if len(args) != function.args {
+		return nil, fmt.Errorf("%s expects %d arguments, got %d", name, function.args, len(args))
+	}
+	for i := 0; i < function.periods; i++ {
+		period, ok := args[i].(numberNode)
+		if !ok || period < 1 || float64(period) != math.Trunc(float64(period)) {
+			return nil, fmt.Errorf("argument %d of %s must be a positive whole number", i+1, name)
+		}
+	}
+	if name == "macd" && args[0].(numberNode) >= args[1].(numberNode) {
+		return nil, fmt.Errorf("the fast period of macd must be shorter than the slow period")
+	}
+	return callNode{function: function, args: args}, nil
+}
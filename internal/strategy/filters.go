@@ -0,0 +1,256 @@
+package strategy
+
+import (
+	"fmt"
+	"strings"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+	pb "momentum-trading-platform/api/proto/strategy_service"
+	"momentum-trading-platform/internal/utils"
+)
+
+// Reason codes reported by the built-in filters when a stock is disqualified.
+const (
+	ReasonNoData              = "NO_DATA"
+	ReasonInsufficientHistory = "INSUFFICIENT_HISTORY"
+	ReasonRecentGap           = "RECENT_GAP"
+	ReasonBelowMovingAverage  = "BELOW_MOVING_AVERAGE"
+	ReasonBelowMinPrice       = "BELOW_MIN_PRICE"
+	ReasonLowDollarVolume     = "LOW_DOLLAR_VOLUME"
+	ReasonExcluded            = "EXCLUDED"
+	ReasonNegativeMomentum    = "NEGATIVE_MOMENTUM"
+	ReasonBelowCashMomentum   = "BELOW_CASH_MOMENTUM"
+	ReasonUndefinedFactor     = "UNDEFINED_FACTOR"
+	ReasonSectorLimit         = "SECTOR_LIMIT"
+)
+
+// Filter decides whether a single stock is eligible for selection.
+type Filter interface {
+	Name() string
+	Apply(stockResp *datapb.StockResponse) *pb.FilterResult
+}
+
+// FilterPipeline applies a sequence of filters. All filters are evaluated so that
+// diagnostics report every failed rule, not just the first one.
+type FilterPipeline []Filter
+
+// Evaluate runs every filter against the stock and reports whether all of them passed.
+func (p FilterPipeline) Evaluate(stockResp *datapb.StockResponse) ([]*pb.FilterResult, bool) {
+	if len(stockResp.DataPoints) == 0 {
+		return []*pb.FilterResult{fail("data", ReasonNoData, "no data points")}, false
+	}
+
+	results := make([]*pb.FilterResult, 0, len(p))
+	passed := true
+	for _, filter := range p {
+		result := filter.Apply(stockResp)
+		passed = passed && result.Passed
+		results = append(results, result)
+	}
+	return results, passed
+}
+
+// FilterConfig holds the settings for the built-in filters. Zero periods fall back to
+// the strategy lookback period when the pipeline is built.
+type FilterConfig struct {
+	GapPeriod          int
+	MaxGap             float64
+	MATrendPeriod      int
+	MinPrice           float64
+	MinDollarVolume    float64
+	DollarVolumePeriod int
+	MinHistory         int
+	MomentumPeriod     int
+	ExcludedSymbols    []string
+}
+
+// DefaultFilterConfig returns the settings matching the original momentum disqualification rules.
+func DefaultFilterConfig() FilterConfig {
+	return FilterConfig{
+		MaxGap:             0.15,
+		MATrendPeriod:      100,
+		DollarVolumePeriod: 20,
+	}
+}
+
+// GapPeriodOr returns the configured gap period, or lookbackPeriod when none is set.
+func (c FilterConfig) GapPeriodOr(lookbackPeriod int) int {
+	if c.GapPeriod > 0 {
+		return c.GapPeriod
+	}
+	return lookbackPeriod
+}
+
+// NewFilterPipeline builds a pipeline from filter names in the given order.
+func NewFilterPipeline(names []string, config FilterConfig, lookbackPeriod int) (FilterPipeline, error) {
+	config.GapPeriod = config.GapPeriodOr(lookbackPeriod)
+	if config.MomentumPeriod <= 0 {
+		config.MomentumPeriod = lookbackPeriod
+	}
+	if config.MinHistory <= 0 {
+		config.MinHistory = max(lookbackPeriod, config.MATrendPeriod)
+	}
+
+	pipeline := make(FilterPipeline, 0, len(names))
+	for _, name := range names {
+		switch strings.TrimSpace(name) {
+		case "":
+			continue
+		case "minHistory":
+			pipeline = append(pipeline, MinHistoryFilter{MinDataPoints: config.MinHistory})
+		case "gap":
+			pipeline = append(pipeline, GapFilter{Period: config.GapPeriod, MaxGap: config.MaxGap})
+		case "maTrend":
+			pipeline = append(pipeline, MATrendFilter{Period: config.MATrendPeriod})
+		case "minPrice":
+			pipeline = append(pipeline, MinPriceFilter{MinPrice: config.MinPrice})
+		case "minDollarVolume":
+			pipeline = append(pipeline, MinDollarVolumeFilter{Period: config.DollarVolumePeriod, MinDollarVolume: config.MinDollarVolume})
+		case "excluded":
+			pipeline = append(pipeline, NewExcludedSymbolsFilter(config.ExcludedSymbols))
+		case "positiveMomentum":
+			pipeline = append(pipeline, PositiveMomentumFilter{Period: config.MomentumPeriod})
+		default:
+			return nil, fmt.Errorf("unknown filter %q", name)
+		}
+	}
+	return pipeline, nil
+}
+
+// MinHistoryFilter requires a minimum number of data points.
+type MinHistoryFilter struct {
+	MinDataPoints int
+}
+
+func (f MinHistoryFilter) Name() string { return "minHistory" }
+
+func (f MinHistoryFilter) Apply(stockResp *datapb.StockResponse) *pb.FilterResult {
+	if len(stockResp.DataPoints) < f.MinDataPoints {
+		return fail(f.Name(), ReasonInsufficientHistory,
+			fmt.Sprintf("only %d data points, need %d", len(stockResp.DataPoints), f.MinDataPoints))
+	}
+	return pass(f.Name())
+}
+
+// GapFilter rejects stocks with an open-to-previous-close gap larger than MaxGap within the last Period days.
+type GapFilter struct {
+	Period int
+	MaxGap float64
+}
+
+func (f GapFilter) Name() string { return "gap" }
+
+func (f GapFilter) Apply(stockResp *datapb.StockResponse) *pb.FilterResult {
+	if utils.HasRecentLargeGap(recentDataPoints(stockResp.DataPoints, f.Period+1), f.Period, f.MaxGap) {
+		return fail(f.Name(), ReasonRecentGap,
+			fmt.Sprintf("gap larger than %.0f%% in the last %d days", f.MaxGap*100, f.Period))
+	}
+	return pass(f.Name())
+}
+
+// MATrendFilter rejects stocks trading below their moving average.
+type MATrendFilter struct {
+	Period int
+}
+
+func (f MATrendFilter) Name() string { return "maTrend" }
+
+func (f MATrendFilter) Apply(stockResp *datapb.StockResponse) *pb.FilterResult {
+	lastPrice := stockResp.DataPoints[len(stockResp.DataPoints)-1].Close
+	movingAverage := utils.CalculateMovingAverage(stockResp.DataPoints, f.Period)
+	if lastPrice < movingAverage {
+		return fail(f.Name(), ReasonBelowMovingAverage,
+			fmt.Sprintf("price %.2f below %d-day moving average %.2f", lastPrice, f.Period, movingAverage))
+	}
+	return pass(f.Name())
+}
+
+// MinPriceFilter rejects stocks whose last close is below MinPrice.
+type MinPriceFilter struct {
+	MinPrice float64
+}
+
+func (f MinPriceFilter) Name() string { return "minPrice" }
+
+func (f MinPriceFilter) Apply(stockResp *datapb.StockResponse) *pb.FilterResult {
+	lastPrice := stockResp.DataPoints[len(stockResp.DataPoints)-1].Close
+	if lastPrice < f.MinPrice {
+		return fail(f.Name(), ReasonBelowMinPrice, fmt.Sprintf("price %.2f below minimum %.2f", lastPrice, f.MinPrice))
+	}
+	return pass(f.Name())
+}
+
+// MinDollarVolumeFilter rejects stocks whose average daily dollar volume over Period days is below MinDollarVolume.
+type MinDollarVolumeFilter struct {
+	Period          int
+	MinDollarVolume float64
+}
+
+func (f MinDollarVolumeFilter) Name() string { return "minDollarVolume" }
+
+func (f MinDollarVolumeFilter) Apply(stockResp *datapb.StockResponse) *pb.FilterResult {
+	recent := recentDataPoints(stockResp.DataPoints, f.Period)
+	total := 0.0
+	for _, dp := range recent {
+		total += dp.Close * float64(dp.Volume)
+	}
+	average := total / float64(len(recent))
+	if average < f.MinDollarVolume {
+		return fail(f.Name(), ReasonLowDollarVolume,
+			fmt.Sprintf("average dollar volume %.0f below minimum %.0f", average, f.MinDollarVolume))
+	}
+	return pass(f.Name())
+}
+
+// ExcludedSymbolsFilter rejects an explicit list of symbols.
+type ExcludedSymbolsFilter struct {
+	symbols map[string]bool
+}
+
+func NewExcludedSymbolsFilter(symbols []string) ExcludedSymbolsFilter {
+	excluded := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		excluded[strings.ToUpper(strings.TrimSpace(symbol))] = true
+	}
+	return ExcludedSymbolsFilter{symbols: excluded}
+}
+
+func (f ExcludedSymbolsFilter) Name() string { return "excluded" }
+
+func (f ExcludedSymbolsFilter) Apply(stockResp *datapb.StockResponse) *pb.FilterResult {
+	if f.symbols[strings.ToUpper(stockResp.Symbol)] {
+		return fail(f.Name(), ReasonExcluded, "symbol is on the exclusion list")
+	}
+	return pass(f.Name())
+}
+
+// PositiveMomentumFilter rejects stocks with a negative momentum score over Period days.
+type PositiveMomentumFilter struct {
+	Period int
+}
+
+func (f PositiveMomentumFilter) Name() string { return "positiveMomentum" }
+
+func (f PositiveMomentumFilter) Apply(stockResp *datapb.StockResponse) *pb.FilterResult {
+	momentumScore := utils.CalculateMomentumScore(stockResp.DataPoints, f.Period)
+	if momentumScore < 0 {
+		return fail(f.Name(), ReasonNegativeMomentum, fmt.Sprintf("negative %d-day momentum score %.4f", f.Period, momentumScore))
+	}
+	return pass(f.Name())
+}
+
+func pass(name string) *pb.FilterResult {
+	return &pb.FilterResult{Name: name, Passed: true}
+}
+
+func fail(name, reasonCode, reason string) *pb.FilterResult {
+	return &pb.FilterResult{Name: name, Passed: false, ReasonCode: reasonCode, Reason: reason}
+}
+
+// recentDataPoints returns at most the last n data points.
+func recentDataPoints(dataPoints []*datapb.StockDataPoint, n int) []*datapb.StockDataPoint {
+	if n <= 0 || len(dataPoints) <= n {
+		return dataPoints
+	}
+	return dataPoints[len(dataPoints)-n:]
+}
@@ -0,0 +1,79 @@
+package strategy
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "momentum-trading-platform/api/proto/strategy_service"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ListSignalRuns(ctx context.Context, req *pb.ListSignalRunsRequest) (*pb.ListSignalRunsResponse, error) {
+	s.Logger.WithFields(log.Fields{
+		"strategy": req.StrategyName,
+		"symbol":   req.Symbol,
+		"from":     req.FromDate,
+		"to":       req.ToDate,
+	}).Info("Listing signal runs")
+
+	runs, err := s.listSignalRuns(req)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to list signal runs")
+		return nil, status.Errorf(codes.Internal, "failed to list signal runs: %v", err)
+	}
+
+	return &pb.ListSignalRunsResponse{Runs: runs}, nil
+}
+
+func (s *Server) GetSignalRun(ctx context.Context, req *pb.GetSignalRunRequest) (*pb.SignalRun, error) {
+	if _, err := uuid.Parse(req.RunId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid run id: %v", err)
+	}
+
+	run, err := s.getSignalRun(req.RunId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "signal run %s not found", req.RunId)
+		}
+		s.Logger.WithError(err).Error("Failed to get signal run")
+		return nil, status.Errorf(codes.Internal, "failed to get signal run: %v", err)
+	}
+
+	return run, nil
+}
+
+// recordSignalRun persists a generated signal response together with the strategy parameters,
+// the symbols it scored and the data range it was produced from, and stamps the response with the
+// new run id. The symbols are the strategy's default universe when the request named none.
+func (s *Server) recordSignalRun(strategyName string, strategy Strategy, req *pb.SignalRequest, symbols []string, resp *pb.SignalResponse) error {
+	parameters := make(map[string]string)
+	for k, v := range strategy.GetParameters() {
+		parameters[k] = fmt.Sprintf("%v", v)
+	}
+
+	run := &pb.SignalRun{
+		RunId:        uuid.New().String(),
+		StrategyName: strategyName,
+		Parameters:   parameters,
+		Symbols:      symbols,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		Interval:     req.Interval,
+		MarketIndex:  req.MarketIndex,
+		CreatedAt:    time.Now().UTC().Format(time.RFC3339),
+		Response:     resp,
+	}
+
+	if err := s.storeSignalRun(run); err != nil {
+		return err
+	}
+
+	resp.RunId = run.RunId
+	return nil
+}
@@ -0,0 +1,247 @@
+package strategy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+	pb "momentum-trading-platform/api/proto/strategy_service"
+	"momentum-trading-platform/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// MeanReversionStrategy buys short-term oversold stocks that remain in a long-term uptrend
+// and exits once they revert. Entries are BUY signals, exits are SELL signals and positions
+// still reverting are reported as HOLD so that existing holdings are kept.
+type MeanReversionStrategy struct {
+	trendPeriod     int
+	rsiPeriod       int
+	entryRSI        float64
+	exitRSI         float64
+	bollingerPeriod int
+	bollingerStdDev float64
+	risk            RiskConfig // Period is the atrPeriod parameter
+	maxPositions    int
+	regimeConfig    RegimeConfig
+	regimeModel     RegimeModel
+	filterNames     []string
+	filterConfig    FilterConfig
+	filters         FilterPipeline
+}
+
+var defaultMeanReversionFilters = []string{"minHistory"}
+
+func NewMeanReversionStrategy() *MeanReversionStrategy {
+	s := &MeanReversionStrategy{
+		trendPeriod:     200,
+		rsiPeriod:       2,
+		entryRSI:        10,
+		exitRSI:         70,
+		bollingerPeriod: 20,
+		bollingerStdDev: 2,
+		risk:            DefaultRiskConfig(),
+		maxPositions:    10,
+		regimeConfig:    DefaultRegimeConfig(),
+		filterNames:     defaultMeanReversionFilters,
+		filterConfig:    DefaultFilterConfig(),
+	}
+	s.regimeModel, _ = s.regimeConfig.NewRegimeModel()
+	s.filters, _ = NewFilterPipeline(s.filterNames, s.filterConfig, s.trendPeriod)
+	return s
+}
+
+// GenerateSignals evaluates every stock for entry and exit conditions. Entries are ranked by how
+// far the short-term RSI is below the entry threshold and capped at maxPositions; the action for
+// the market regime then applies, by default moving the portfolio to cash in a bear market.
+func (s *MeanReversionStrategy) GenerateSignals(batchStockData map[string]*datapb.StockResponse, indexData *datapb.StockResponse) (*pb.SignalResponse, error) {
+	assessment := s.DetectMarketRegime(indexData, batchStockData)
+	regime := assessment.Regime
+
+	var entries, others []*pb.StockSignal
+	diagnostics := make(map[string]*pb.SignalDiagnostic, len(batchStockData))
+
+	for symbol, data := range batchStockData {
+		signal, diagnostic := s.generateSignal(symbol, data)
+		diagnostic.MarketRegime = pb.MarketRegime(regime)
+		diagnostics[symbol] = diagnostic
+		switch {
+		case signal == nil:
+		case signal.Signal == pb.SignalType_BUY:
+			entries = append(entries, signal)
+		default:
+			others = append(others, signal)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].MomentumScore != entries[j].MomentumScore {
+			return entries[i].MomentumScore > entries[j].MomentumScore
+		}
+		return entries[i].Symbol < entries[j].Symbol
+	})
+	for i, signal := range entries {
+		diagnostics[signal.Symbol].Rank = int32(i + 1)
+		if i >= s.maxPositions {
+			signal.Signal = pb.SignalType_HOLD
+		}
+	}
+	log.Infof("🔍 Found %d oversold stocks in an uptrend, %d exits or holds", len(entries), len(others))
+
+	sort.Slice(others, func(i, j int) bool { return others[i].Symbol < others[j].Symbol })
+	action := s.regimeConfig.Action(regime)
+	signals := applyRegimeAction(append(entries, others...), action)
+	s.risk.allocate(signals, batchStockData)
+	for _, signal := range signals {
+		if signal.Signal == pb.SignalType_BUY {
+			diagnostics[signal.Symbol].Selected = true
+		}
+	}
+
+	return setRegime(&pb.SignalResponse{
+		Signals:     signals,
+		Diagnostics: sortDiagnostics(diagnostics),
+	}, assessment, action, s.regimeConfig.Exposure(action)), nil
+}
+
+// generateSignal classifies a stock as an entry, exit or hold. The returned signal is nil when the stock is disqualified.
+func (s *MeanReversionStrategy) generateSignal(symbol string, stockResp *datapb.StockResponse) (*pb.StockSignal, *pb.SignalDiagnostic) {
+	diagnostic := &pb.SignalDiagnostic{Symbol: symbol}
+	filterResults, passed := s.filters.Evaluate(stockResp)
+	diagnostic.Filters = filterResults
+	if !passed {
+		return nil, diagnostic
+	}
+
+	dataPoints := stockResp.DataPoints
+	lastPrice := dataPoints[len(dataPoints)-1].Close
+	trendMA := utils.CalculateMovingAverage(dataPoints, s.trendPeriod)
+	rsi := utils.CalculateRSI(dataPoints, s.rsiPeriod)
+	lower, middle, _ := utils.CalculateBollingerBands(dataPoints, s.bollingerPeriod, s.bollingerStdDev)
+
+	diagnostic.Atr = utils.CalculateATR(dataPoints, s.risk.Period)
+	if trendMA > 0 {
+		diagnostic.MaDistance = (lastPrice - trendMA) / trendMA
+	}
+	diagnostic.MomentumScore = s.entryRSI - rsi
+
+	signal := &pb.StockSignal{
+		Symbol:        symbol,
+		Signal:        pb.SignalType_HOLD,
+		MomentumScore: s.entryRSI - rsi,
+		CurrentPrice:  lastPrice,
+	}
+	s.risk.applyRisk(signal, dataPoints)
+
+	uptrend := lastPrice > trendMA
+	trendResult := pass("uptrend")
+	if !uptrend {
+		trendResult = fail("uptrend", ReasonBelowMovingAverage,
+			fmt.Sprintf("price %.2f below %d-day moving average %.2f", lastPrice, s.trendPeriod, trendMA))
+	}
+	diagnostic.Filters = append(diagnostic.Filters, trendResult)
+
+	switch {
+	case !uptrend || rsi > s.exitRSI || lastPrice > middle:
+		signal.Signal = pb.SignalType_SELL
+	case rsi < s.entryRSI || lastPrice < lower:
+		signal.Signal = pb.SignalType_BUY
+	}
+
+	return signal, diagnostic
+}
+
+func (s *MeanReversionStrategy) CalculateRisk(stockData *datapb.StockResponse) float64 {
+	riskUnit, _ := s.risk.riskUnit(stockData.DataPoints)
+	return riskUnit
+}
+
+func (s *MeanReversionStrategy) DetectMarketRegime(indexData *datapb.StockResponse, universe map[string]*datapb.StockResponse) RegimeAssessment {
+	return s.regimeModel.Assess(indexData, universe)
+}
+
+func (s *MeanReversionStrategy) ParameterSchema() []ParameterSpec {
+	return append([]ParameterSpec{
+		{Name: "trendPeriod", Type: IntParameter, Default: 200, Min: 50, Max: 300, Description: "Moving average defining the long-term uptrend"},
+		{Name: "rsiPeriod", Type: IntParameter, Default: 2, Min: 2, Max: 30, Description: "RSI period for the short-term oversold reading"},
+		{Name: "entryRSI", Type: FloatParameter, Default: 10.0, Min: 1, Max: 50, Description: "RSI below which a stock is oversold"},
+		{Name: "exitRSI", Type: FloatParameter, Default: 70.0, Min: 50, Max: 99, Description: "RSI above which a position is exited"},
+		{Name: "bollingerPeriod", Type: IntParameter, Default: 20, Min: 5, Max: 100, Description: "Bollinger Band period"},
+		{Name: "bollingerStdDev", Type: FloatParameter, Default: 2.0, Min: 0.5, Max: 4, Description: "Bollinger Band width in standard deviations"},
+		{Name: "maxPositions", Type: IntParameter, Default: 10, Min: 1, Max: 100, Description: "Maximum number of new entries per run"},
+		{Name: "filters", Type: ListParameter, Default: strings.Join(defaultMeanReversionFilters, ","), Description: "Disqualification filters in evaluation order"},
+		{Name: "minPrice", Type: FloatParameter, Default: 0.0, Description: "Minimum last close"},
+		{Name: "minDollarVolume", Type: FloatParameter, Default: 0.0, Description: "Minimum average daily dollar volume"},
+		{Name: "excludedSymbols", Type: ListParameter, Default: "", Description: "Symbols that are never selected"},
+	}, append(riskParameterSchema(DefaultRiskConfig(), "atrPeriod"), regimeParameterSchema(DefaultRegimeConfig())...)...)
+}
+
+func (s *MeanReversionStrategy) GetParameters() map[string]interface{} {
+	return regimeParameters(s.regimeConfig, riskParameters(s.risk, "atrPeriod", map[string]interface{}{
+		"trendPeriod":     s.trendPeriod,
+		"rsiPeriod":       s.rsiPeriod,
+		"entryRSI":        s.entryRSI,
+		"exitRSI":         s.exitRSI,
+		"bollingerPeriod": s.bollingerPeriod,
+		"bollingerStdDev": s.bollingerStdDev,
+		"maxPositions":    s.maxPositions,
+		"filters":         strings.Join(s.filterNames, ","),
+		"minPrice":        s.filterConfig.MinPrice,
+		"minDollarVolume": s.filterConfig.MinDollarVolume,
+		"excludedSymbols": strings.Join(s.filterConfig.ExcludedSymbols, ","),
+	}))
+}
+
+func (s *MeanReversionStrategy) SetParameters(params map[string]interface{}) error {
+	next := *s
+
+	r := newParamReader(params)
+	r.Int("trendPeriod", &next.trendPeriod)
+	r.Int("rsiPeriod", &next.rsiPeriod)
+	r.Float("entryRSI", &next.entryRSI)
+	r.Float("exitRSI", &next.exitRSI)
+	r.Int("bollingerPeriod", &next.bollingerPeriod)
+	r.Float("bollingerStdDev", &next.bollingerStdDev)
+	readRiskParameters(r, &next.risk, "atrPeriod")
+	r.Int("maxPositions", &next.maxPositions)
+	readRegimeParameters(r, &next.regimeConfig)
+	r.List("filters", &next.filterNames)
+	r.Float("minPrice", &next.filterConfig.MinPrice)
+	r.Float("minDollarVolume", &next.filterConfig.MinDollarVolume)
+	r.List("excludedSymbols", &next.filterConfig.ExcludedSymbols)
+	if err := r.Err(); err != nil {
+		return err
+	}
+
+	if next.trendPeriod <= 0 || next.rsiPeriod <= 0 || next.bollingerPeriod <= 0 {
+		return fmt.Errorf("periods must be positive")
+	}
+	if err := next.risk.validate(); err != nil {
+		return err
+	}
+	if next.entryRSI >= next.exitRSI {
+		return fmt.Errorf("entryRSI (%v) must be below exitRSI (%v)", next.entryRSI, next.exitRSI)
+	}
+	if next.maxPositions <= 0 {
+		return fmt.Errorf("maxPositions must be positive")
+	}
+
+	if err := next.regimeConfig.validate(); err != nil {
+		return err
+	}
+	regimeModel, err := next.regimeConfig.NewRegimeModel()
+	if err != nil {
+		return err
+	}
+	next.regimeModel = regimeModel
+
+	filters, err := NewFilterPipeline(next.filterNames, next.filterConfig, next.trendPeriod)
+	if err != nil {
+		return err
+	}
+	next.filters = filters
+
+	*s = next
+	return nil
+}
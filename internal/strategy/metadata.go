@@ -0,0 +1,19 @@
+package strategy
+
+// MetadataProvider looks up the sector of each symbol. Symbols it cannot classify are left out of
+// the returned map.
+type MetadataProvider interface {
+	Sectors(symbols []string) (map[string]string, error)
+}
+
+// MetadataAware is implemented by strategies whose selection uses security metadata.
+type MetadataAware interface {
+	SetMetadataProvider(provider MetadataProvider)
+}
+
+// setMetadataProvider hands the provider to the strategy if it uses one.
+func setMetadataProvider(strategy Strategy, provider MetadataProvider) {
+	if aware, ok := strategy.(MetadataAware); ok && provider != nil {
+		aware.SetMetadataProvider(provider)
+	}
+}
@@ -1,147 +1,284 @@
 package strategy
 
 import (
+	"fmt"
 	datapb "momentum-trading-platform/api/proto/data_service"
 	pb "momentum-trading-platform/api/proto/strategy_service"
 	"momentum-trading-platform/internal/utils"
-	"sort"
+	"slices"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 )
 
 // MomentumStrategy defines the structure of the momentum trading strategy.
 type MomentumStrategy struct {
-	lookbackPeriod     int
-	topPercentage      float64
-	riskFactor         float64
-	marketRegimePeriod int // Period for calculating 200-day moving average
+	lookbackPeriod int
+	selection      SelectionConfig
+	risk           RiskConfig
+	regimeConfig   RegimeConfig
+	regimeModel    RegimeModel
+	filterNames    []string
+	filterConfig   FilterConfig
+	filters        FilterPipeline
+	metadata       MetadataProvider
 }
 
+var defaultMomentumFilters = []string{"minHistory", "gap", "maTrend", "positiveMomentum"}
+
 func NewMomentumStrategy() *MomentumStrategy {
-	return &MomentumStrategy{
-		lookbackPeriod:     90,
-		topPercentage:      0.2,
-		riskFactor:         0.001,
-		marketRegimePeriod: 200,
+	s := &MomentumStrategy{
+		lookbackPeriod: 90,
+		selection:      DefaultSelectionConfig(),
+		risk:           DefaultRiskConfig(),
+		regimeConfig:   DefaultRegimeConfig(),
+		filterNames:    defaultMomentumFilters,
+		filterConfig:   DefaultFilterConfig(),
 	}
+	s.regimeModel, _ = s.regimeConfig.NewRegimeModel()
+	s.filters, _ = NewFilterPipeline(s.filterNames, s.filterConfig, s.lookbackPeriod)
+	return s
 }
 
 // GenerateSignals generates trading signals based on the provided batch of stock data.
-func (s *MomentumStrategy) GenerateSignals(batchStockData map[string]*datapb.StockResponse, indexData *datapb.StockResponse) ([]*pb.StockSignal, error) {
-	regime := s.DetectMarketRegime(indexData)
+// Every symbol in the batch gets a diagnostic record explaining why it was or was not selected.
+// The selection is then adjusted by the action configured for the market regime.
+func (s *MomentumStrategy) GenerateSignals(batchStockData map[string]*datapb.StockResponse, indexData *datapb.StockResponse) (*pb.SignalResponse, error) {
+	assessment := s.DetectMarketRegime(indexData, batchStockData)
+	regime := assessment.Regime
 
-	if regime == Bear {
-		// In a bear market, we don't trade
-		return []*pb.StockSignal{}, nil
+	// Symbols are scored concurrently, each into its own slot, and collected in symbol order
+	symbols := make([]string, 0, len(batchStockData))
+	for symbol := range batchStockData {
+		symbols = append(symbols, symbol)
 	}
+	slices.Sort(symbols)
+	scored := make([]*pb.StockSignal, len(symbols))
+	scoredDiagnostics := make([]*pb.SignalDiagnostic, len(symbols))
+	forEachParallel(len(symbols), func(i int) {
+		scored[i], scoredDiagnostics[i] = s.generateSignal(symbols[i], batchStockData[symbols[i]])
+	})
 
 	var signals []*pb.StockSignal
+	diagnostics := make(map[string]*pb.SignalDiagnostic, len(batchStockData))
+	disqualified := 0
+	for i, symbol := range symbols {
+		diagnostic := scoredDiagnostics[i]
+		diagnostic.MarketRegime = pb.MarketRegime(regime)
+		diagnostics[symbol] = diagnostic
+		if scored[i] != nil {
+			signals = append(signals, scored[i])
+		} else {
+			disqualified++
+		}
+	}
+	if disqualified > 0 {
+		log.Infof("🗑️ Disqualified %d of %d stocks, see the signal diagnostics for reasons", disqualified, len(symbols))
+	}
 
-	for symbol, data := range batchStockData {
-		signal := s.generateSignal(symbol, data)
-		signals = append(signals, signal)
+	sectors := s.lookupSectors(signals)
+	result := s.selection.selectSignals(signals, sectors)
+	for i, signal := range result.ranked {
+		diagnostic := diagnostics[signal.Symbol]
+		diagnostic.Rank = int32(i + 1)
+		diagnostic.Sector = sectorOf(sectors, signal.Symbol)
+		diagnostic.SectorRank = int32(result.sectorRanks[signal.Symbol])
+		if limited, ok := result.limited[signal.Symbol]; ok {
+			diagnostic.Filters = append(diagnostic.Filters, limited)
+		}
 	}
+	log.Infof("🔍 Found %d stocks, selected %d based on momentum score", len(signals), len(result.selected))
+	selected := result.selected
 
-	return s.sortAndFilterSignals(signals), nil
+	action := s.regimeConfig.Action(regime)
+	if action != RegimeFull {
+		log.Infof("🧭 Market regime %s, applying %s", pb.MarketRegime(regime), action)
+	}
+	selected = applyRegimeAction(selected, action)
+	s.risk.allocate(selected, batchStockData)
+	for _, signal := range selected {
+		diagnostics[signal.Symbol].Selected = true
+	}
+
+	return setRegime(&pb.SignalResponse{
+		Signals:     selected,
+		Diagnostics: sortDiagnostics(diagnostics),
+	}, assessment, action, s.regimeConfig.Exposure(action)), nil
 }
 
-// generateSignal generates a trading signal for a specific stock.
-func (s *MomentumStrategy) generateSignal(symbol string, stockResp *datapb.StockResponse) *pb.StockSignal {
+// generateSignal generates a trading signal for a specific stock along with its diagnostic record.
+// The returned signal is nil when the stock is disqualified.
+func (s *MomentumStrategy) generateSignal(symbol string, stockResp *datapb.StockResponse) (*pb.StockSignal, *pb.SignalDiagnostic) {
+	diagnostic := &pb.SignalDiagnostic{Symbol: symbol}
+	if len(stockResp.DataPoints) == 0 {
+		diagnostic.Filters, _ = s.filters.Evaluate(stockResp)
+		return nil, diagnostic
+	}
+
 	lastPrice := stockResp.DataPoints[len(stockResp.DataPoints)-1].Close
-	momentumScore := utils.CalculateMomentumScore(stockResp.DataPoints, s.lookbackPeriod)
-	riskUnit := s.CalculateRisk(stockResp)
+	slope, r2 := utils.CalculateMomentumRegression(stockResp.DataPoints, s.lookbackPeriod)
+	momentumScore := slope * r2
+
+	diagnostic.MomentumSlope = slope
+	diagnostic.RSquared = r2
+	diagnostic.MomentumScore = momentumScore
+	diagnostic.Atr = utils.CalculateATR(stockResp.DataPoints, s.risk.Period)
+	gapPeriod := s.filterConfig.GapPeriodOr(s.lookbackPeriod)
+	diagnostic.HasLargeGap = utils.HasRecentLargeGap(recentDataPoints(stockResp.DataPoints, gapPeriod+1), gapPeriod, s.filterConfig.MaxGap)
+	if movingAverage := utils.CalculateMovingAverage(stockResp.DataPoints, s.filterConfig.MATrendPeriod); movingAverage > 0 {
+		diagnostic.MaDistance = (lastPrice - movingAverage) / movingAverage
+	}
 
 	// Disqualified stocks are not traded
-	if s.isStockDisqualified(stockResp) {
-		return &pb.StockSignal{}
+	filterResults, passed := s.filters.Evaluate(stockResp)
+	diagnostic.Filters = filterResults
+	if !passed {
+		if log.IsLevelEnabled(log.DebugLevel) {
+			for _, result := range filterResults {
+				if !result.Passed {
+					log.WithFields(log.Fields{
+						"symbol": symbol,
+						"reason": result.ReasonCode,
+					}).Debugf("🗑️ Stock disqualified: %s", result.Reason)
+				}
+			}
+		}
+		return nil, diagnostic
 	}
 
-	return &pb.StockSignal{
+	signal := &pb.StockSignal{
 		Symbol:        symbol,
 		Signal:        pb.SignalType_BUY,
-		RiskUnit:      riskUnit,
 		MomentumScore: momentumScore,
 		CurrentPrice:  lastPrice,
 	}
+	s.risk.applyRisk(signal, stockResp.DataPoints)
+	return signal, diagnostic
 }
 
-// isStockDisqualified checks if a stock is disqualified based on certain criteria.
-func (s *MomentumStrategy) isStockDisqualified(stockResp *datapb.StockResponse) bool {
-	// Implementation similar to the current isStockDisqualified function
-	if utils.HasRecentLargeGap(stockResp.DataPoints, 90, 0.15) {
-		log.WithField("symbol", stockResp.Symbol).Info("🗑️ Stock disqualified due to recent large gap")
-		return true
+// lookupSectors fetches sectors for the candidates when the selection needs them. Without
+// metadata the selection falls back to ranking every candidate as unclassified.
+func (s *MomentumStrategy) lookupSectors(signals []*pb.StockSignal) map[string]string {
+	if !s.selection.needsMetadata() || len(signals) == 0 {
+		return nil
 	}
-	lastPrice := stockResp.DataPoints[len(stockResp.DataPoints)-1].Close
-	movingAverage := utils.CalculateMovingAverage(stockResp.DataPoints, 100)
-	if lastPrice < movingAverage {
-		log.WithField("symbol", stockResp.Symbol).Info("🗑️ Stock disqualified due to being below 100MA")
-		return true
+	if s.metadata == nil {
+		log.Warn("⚠️ Sector-constrained selection configured but no metadata provider is available")
+		return nil
 	}
 
-	momentumScore := utils.CalculateMomentumScore(stockResp.DataPoints, 90)
-	if momentumScore < 0 {
-		log.WithField("symbol", stockResp.Symbol).Info("🗑️ Stock disqualified due to negative momentum score")
-		return true
+	symbols := make([]string, len(signals))
+	for i, signal := range signals {
+		symbols[i] = signal.Symbol
 	}
-
-	return false
+	sectors, err := s.metadata.Sectors(symbols)
+	if err != nil {
+		log.WithError(err).Warn("⚠️ Failed to fetch sectors, selecting without sector constraints")
+	}
+	return sectors
 }
 
-func (s *MomentumStrategy) sortAndFilterSignals(signals []*pb.StockSignal) []*pb.StockSignal {
-	sort.Slice(signals, func(i, j int) bool {
-		return signals[i].MomentumScore > signals[j].MomentumScore
-	})
-
-	topCount := int(float64(len(signals)) * s.topPercentage)
-	log.Infof("🔍 Found %d stocks, selecting top %d based on momentum score", len(signals), topCount)
-	return signals[:topCount]
+func (s *MomentumStrategy) SetMetadataProvider(provider MetadataProvider) {
+	s.metadata = provider
 }
 
 func (s *MomentumStrategy) CalculateRisk(stockData *datapb.StockResponse) float64 {
-	atr := utils.CalculateATR(stockData.DataPoints, 20)
-	return utils.CalculateRiskUnit(atr, s.riskFactor)
+	riskUnit, _ := s.risk.riskUnit(stockData.DataPoints)
+	return riskUnit
 }
 
-func (s *MomentumStrategy) DetectMarketRegime(indexData *datapb.StockResponse) MarketRegime {
-	if len(indexData.DataPoints) < 200 {
-		log.Warnf("❗ Not enough data points to detect market regime, expected 200 but got %d", len(indexData.DataPoints))
-		return Neutral
-	}
-
-	currentPrice := indexData.DataPoints[len(indexData.DataPoints)-1].Close
-	ma200 := utils.CalculateMovingAverage(indexData.DataPoints, s.marketRegimePeriod)
+func (s *MomentumStrategy) DetectMarketRegime(indexData *datapb.StockResponse, universe map[string]*datapb.StockResponse) RegimeAssessment {
+	return s.regimeModel.Assess(indexData, universe)
+}
 
-	if currentPrice > ma200 {
-		log.Infof("📈 Market regime: Bull, current price: %.2f, 200MA: %.2f", currentPrice, ma200)
-		return Bull
-	} else {
-		log.Infof("📉 Market regime: Bear, current price: %.2f, 200MA: %.2f", currentPrice, ma200)
-		return Bear
-	}
+func (s *MomentumStrategy) ParameterSchema() []ParameterSpec {
+	return append([]ParameterSpec{
+		{Name: "lookbackPeriod", Type: IntParameter, Default: 90, Min: 20, Max: 252, Description: "Days used for the momentum regression"},
+		{Name: "filters", Type: ListParameter, Default: strings.Join(defaultMomentumFilters, ","), Description: "Disqualification filters in evaluation order"},
+		{Name: "gapPeriod", Type: IntParameter, Default: 0, Min: 0, Max: 252, Description: "Days checked for large gaps, 0 uses lookbackPeriod"},
+		{Name: "maxGap", Type: FloatParameter, Default: 0.15, Min: 0.01, Max: 1, Description: "Largest allowed open-to-close gap"},
+		{Name: "maTrendPeriod", Type: IntParameter, Default: 100, Min: 10, Max: 300, Description: "Moving average the price must stay above"},
+		{Name: "minPrice", Type: FloatParameter, Default: 0.0, Description: "Minimum last close"},
+		{Name: "minDollarVolume", Type: FloatParameter, Default: 0.0, Description: "Minimum average daily dollar volume"},
+		{Name: "dollarVolumePeriod", Type: IntParameter, Default: 20, Min: 1, Max: 252, Description: "Days averaged for dollar volume"},
+		{Name: "minHistory", Type: IntParameter, Default: 0, Min: 0, Max: 1000, Description: "Minimum data points, 0 derives it from the periods"},
+		{Name: "excludedSymbols", Type: ListParameter, Default: "", Description: "Symbols that are never selected"},
+	}, slices.Concat(selectionParameterSchema(DefaultSelectionConfig()), riskParameterSchema(DefaultRiskConfig(), "riskPeriod"), regimeParameterSchema(DefaultRegimeConfig()))...)
 }
 
 func (s *MomentumStrategy) GetParameters() map[string]interface{} {
-	return map[string]interface{}{
+	return regimeParameters(s.regimeConfig, selectionParameters(s.selection, riskParameters(s.risk, "riskPeriod", map[string]interface{}{
 		"lookbackPeriod":     s.lookbackPeriod,
-		"topPercentage":      s.topPercentage,
-		"riskFactor":         s.riskFactor,
-		"marketRegimePeriod": s.marketRegimePeriod,
-	}
+		"filters":            strings.Join(s.filterNames, ","),
+		"gapPeriod":          s.filterConfig.GapPeriod,
+		"maxGap":             s.filterConfig.MaxGap,
+		"maTrendPeriod":      s.filterConfig.MATrendPeriod,
+		"minPrice":           s.filterConfig.MinPrice,
+		"minDollarVolume":    s.filterConfig.MinDollarVolume,
+		"dollarVolumePeriod": s.filterConfig.DollarVolumePeriod,
+		"minHistory":         s.filterConfig.MinHistory,
+		"excludedSymbols":    strings.Join(s.filterConfig.ExcludedSymbols, ","),
+	})))
 }
 
+// SetParameters updates the strategy parameters. Values may be typed or strings as received
+// from ConfigureStrategy. The filter pipeline is rebuilt and validated before anything is applied.
 func (s *MomentumStrategy) SetParameters(params map[string]interface{}) error {
-	if lookbackPeriod, ok := params["lookbackPeriod"].(int); ok {
-		s.lookbackPeriod = lookbackPeriod
+	lookbackPeriod := s.lookbackPeriod
+	selection := s.selection
+	risk := s.risk
+	regimeConfig := s.regimeConfig
+	filterNames := s.filterNames
+	filterConfig := s.filterConfig
+
+	r := newParamReader(params)
+	r.Int("lookbackPeriod", &lookbackPeriod)
+	readSelectionParameters(r, &selection)
+	readRiskParameters(r, &risk, "riskPeriod")
+	readRegimeParameters(r, &regimeConfig)
+	r.List("filters", &filterNames)
+	r.Int("gapPeriod", &filterConfig.GapPeriod)
+	r.Float("maxGap", &filterConfig.MaxGap)
+	r.Int("maTrendPeriod", &filterConfig.MATrendPeriod)
+	r.Float("minPrice", &filterConfig.MinPrice)
+	r.Float("minDollarVolume", &filterConfig.MinDollarVolume)
+	r.Int("dollarVolumePeriod", &filterConfig.DollarVolumePeriod)
+	r.Int("minHistory", &filterConfig.MinHistory)
+	r.List("excludedSymbols", &filterConfig.ExcludedSymbols)
+	if err := r.Err(); err != nil {
+		return err
 	}
-	if topPercentage, ok := params["topPercentage"].(float64); ok {
-		s.topPercentage = topPercentage
+
+	if lookbackPeriod <= 0 {
+		return fmt.Errorf("lookbackPeriod must be positive")
+	}
+	if err := selection.validate(); err != nil {
+		return err
 	}
-	if riskFactor, ok := params["riskFactor"].(float64); ok {
-		s.riskFactor = riskFactor
+	if err := risk.validate(); err != nil {
+		return err
+	}
+
+	if err := regimeConfig.validate(); err != nil {
+		return err
 	}
-	if marketRegimePeriod, ok := params["marketRegimePeriod"].(int); ok {
-		s.marketRegimePeriod = marketRegimePeriod
+	regimeModel, err := regimeConfig.NewRegimeModel()
+	if err != nil {
+		return err
 	}
+
+	filters, err := NewFilterPipeline(filterNames, filterConfig, lookbackPeriod)
+	if err != nil {
+		return err
+	}
+
+	s.lookbackPeriod = lookbackPeriod
+	s.selection = selection
+	s.risk = risk
+	s.regimeConfig = regimeConfig
+	s.regimeModel = regimeModel
+	s.filterNames = filterNames
+	s.filterConfig = filterConfig
+	s.filters = filters
 	return nil
 }
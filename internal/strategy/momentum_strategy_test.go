@@ -0,0 +1,79 @@
+package strategy
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"testing"
+	"time"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	benchmarkUniverseSize = 3000
+	benchmarkBars         = 300
+)
+
+// syntheticStockData returns daily bars following a random walk with a per-symbol drift, so that
+// some symbols trend up and pass the momentum filters while others do not.
+func syntheticStockData(rng *rand.Rand, symbol string, bars int) *datapb.StockResponse {
+	start := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	drift := rng.NormFloat64() * 0.001
+	price := 20 + rng.Float64()*180
+	dataPoints := make([]*datapb.StockDataPoint, bars)
+	for i := range dataPoints {
+		open := price
+		price *= math.Exp(drift + rng.NormFloat64()*0.015)
+		dataPoints[i] = &datapb.StockDataPoint{
+			Timestamp:     start.AddDate(0, 0, i).Unix(),
+			Open:          open,
+			High:          math.Max(open, price) * 1.005,
+			Low:           math.Min(open, price) * 0.995,
+			Close:         price,
+			AdjustedClose: price,
+			Volume:        int64(100000 + rng.Intn(900000)),
+		}
+	}
+	return &datapb.StockResponse{Symbol: symbol, DataPoints: dataPoints}
+}
+
+func syntheticUniverse(size, bars int) (map[string]*datapb.StockResponse, *datapb.StockResponse) {
+	rng := rand.New(rand.NewSource(1))
+	batch := make(map[string]*datapb.StockResponse, size)
+	for i := 0; i < size; i++ {
+		symbol := fmt.Sprintf("S%04d", i)
+		batch[symbol] = syntheticStockData(rng, symbol, bars)
+	}
+	return batch, syntheticStockData(rng, "^GSPC", bars)
+}
+
+func benchmarkMomentumSignals(b *testing.B, procs int) {
+	batch, index := syntheticUniverse(benchmarkUniverseSize, benchmarkBars)
+	strategy := NewMomentumStrategy()
+
+	level := log.GetLevel()
+	log.SetLevel(log.WarnLevel)
+	defer log.SetLevel(level)
+	// forEachParallel runs on the calling goroutine when only one processor is available
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(procs))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := strategy.GenerateSignals(batch, index); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMomentumGenerateSignalsSerial(b *testing.B) {
+	benchmarkMomentumSignals(b, 1)
+}
+
+func BenchmarkMomentumGenerateSignalsParallel(b *testing.B) {
+	benchmarkMomentumSignals(b, runtime.NumCPU())
+}
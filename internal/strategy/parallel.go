@@ -0,0 +1,37 @@
+package strategy
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelMinBatch is the number of symbols below which scoring stays on the calling goroutine,
+// where starting workers would cost more than it saves.
+const parallelMinBatch = 64
+
+// forEachParallel calls fn for every index in [0, n) on a pool of up to GOMAXPROCS workers. Workers
+// claim indexes from a shared counter so that slow symbols do not hold up a fixed share of the
+// batch. fn must only write to state owned by its index.
+func forEachParallel(n int, fn func(i int)) {
+	workers := min(runtime.GOMAXPROCS(0), n)
+	if n < parallelMinBatch || workers <= 1 {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := int(next.Add(1) - 1); i < n; i = int(next.Add(1) - 1) {
+				fn(i)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,143 @@
+package strategy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parameters arrive either typed (from Go callers) or as strings (from ConfigureStrategy),
+// so the helpers below accept both forms.
+
+func intParam(params map[string]interface{}, key string) (int, bool, error) {
+	value, ok := params[key]
+	if !ok {
+		return 0, false, nil
+	}
+	switch v := value.(type) {
+	case int:
+		return v, true, nil
+	case int32:
+		return int(v), true, nil
+	case int64:
+		return int(v), true, nil
+	case float64:
+		return int(v), true, nil
+	case string:
+		parsed, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid value for %s: %q is not an integer", key, v)
+		}
+		return parsed, true, nil
+	default:
+		return 0, false, fmt.Errorf("invalid type %T for %s", value, key)
+	}
+}
+
+func floatParam(params map[string]interface{}, key string) (float64, bool, error) {
+	value, ok := params[key]
+	if !ok {
+		return 0, false, nil
+	}
+	switch v := value.(type) {
+	case float64:
+		return v, true, nil
+	case float32:
+		return float64(v), true, nil
+	case int:
+		return float64(v), true, nil
+	case string:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid value for %s: %q is not a number", key, v)
+		}
+		return parsed, true, nil
+	default:
+		return 0, false, fmt.Errorf("invalid type %T for %s", value, key)
+	}
+}
+
+func stringParam(params map[string]interface{}, key string) (string, bool, error) {
+	value, ok := params[key]
+	if !ok {
+		return "", false, nil
+	}
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v), true, nil
+	default:
+		return fmt.Sprintf("%v", v), true, nil
+	}
+}
+
+// listParam reads a comma-separated list, also accepting a []string value.
+func listParam(params map[string]interface{}, key string) ([]string, bool, error) {
+	value, ok := params[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if list, ok := value.([]string); ok {
+		return list, true, nil
+	}
+	str, _, err := stringParam(params, key)
+	if err != nil {
+		return nil, false, err
+	}
+	var list []string
+	for _, item := range strings.Split(str, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list, true, nil
+}
+
+// paramReader copies parameters into typed targets, leaving targets untouched for missing keys
+// and collecting every parse error so they can be reported together.
+type paramReader struct {
+	params map[string]interface{}
+	errs   []string
+}
+
+func newParamReader(params map[string]interface{}) *paramReader {
+	return &paramReader{params: params}
+}
+
+func (r *paramReader) Int(key string, target *int) {
+	if v, ok, err := intParam(r.params, key); err != nil {
+		r.errs = append(r.errs, err.Error())
+	} else if ok {
+		*target = v
+	}
+}
+
+func (r *paramReader) Float(key string, target *float64) {
+	if v, ok, err := floatParam(r.params, key); err != nil {
+		r.errs = append(r.errs, err.Error())
+	} else if ok {
+		*target = v
+	}
+}
+
+func (r *paramReader) String(key string, target *string) {
+	if v, ok, err := stringParam(r.params, key); err != nil {
+		r.errs = append(r.errs, err.Error())
+	} else if ok {
+		*target = v
+	}
+}
+
+func (r *paramReader) List(key string, target *[]string) {
+	if v, ok, err := listParam(r.params, key); err != nil {
+		r.errs = append(r.errs, err.Error())
+	} else if ok {
+		*target = v
+	}
+}
+
+func (r *paramReader) Err() error {
+	if len(r.errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.Join(r.errs, "; "))
+}
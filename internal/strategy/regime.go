@@ -0,0 +1,444 @@
+package strategy
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+	pb "momentum-trading-platform/api/proto/strategy_service"
+	"momentum-trading-platform/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// RegimeAssessment is the output of a regime model: the detected regime, how confident the
+// model is (0 to 1) and the individual readings that led to it.
+type RegimeAssessment struct {
+	Regime     MarketRegime
+	Confidence float64
+	Signals    []*pb.RegimeSignal
+}
+
+// RegimeModel classifies the market from the index series and, for breadth-based models, the
+// stocks in the universe.
+type RegimeModel interface {
+	Name() string
+	Assess(indexData *datapb.StockResponse, universe map[string]*datapb.StockResponse) RegimeAssessment
+}
+
+// neutralAssessment is returned when a model lacks the data to make a call.
+func neutralAssessment(model string) RegimeAssessment {
+	return RegimeAssessment{
+		Regime:  Neutral,
+		Signals: []*pb.RegimeSignal{{Model: model, Regime: pb.MarketRegime_NEUTRAL}},
+	}
+}
+
+func singleAssessment(model string, regime MarketRegime, confidence, value float64) RegimeAssessment {
+	return RegimeAssessment{
+		Regime:     regime,
+		Confidence: confidence,
+		Signals: []*pb.RegimeSignal{{
+			Model:      model,
+			Regime:     pb.MarketRegime(regime),
+			Confidence: confidence,
+			Value:      value,
+		}},
+	}
+}
+
+// classifyReading maps a reading onto a regime given the level at which it turns bullish and the
+// level at which it turns bearish; bullAt may be above or below bearAt. Confidence is 0.5 at a
+// threshold and reaches 1 one band width beyond it. Inside the neutral band it peaks at the midpoint.
+func classifyReading(value, bullAt, bearAt float64) (MarketRegime, float64) {
+	x := (value - bearAt) / (bullAt - bearAt) // 0 at the bear threshold, 1 at the bull threshold
+	switch {
+	case x >= 1:
+		return Bull, math.Min(1, 0.5+(x-1)/2)
+	case x <= 0:
+		return Bear, math.Min(1, 0.5-x/2)
+	default:
+		return Neutral, 1 - math.Abs(x-0.5)
+	}
+}
+
+// MovingAverageRegimeModel compares the latest index close to its moving average. Confidence
+// grows with the distance from the average and is full 5% away from it.
+type MovingAverageRegimeModel struct {
+	Period int
+}
+
+func (m MovingAverageRegimeModel) Name() string { return "ma" }
+
+func (m MovingAverageRegimeModel) Assess(indexData *datapb.StockResponse, _ map[string]*datapb.StockResponse) RegimeAssessment {
+	if indexData == nil || len(indexData.DataPoints) < m.Period {
+		dataPoints := 0
+		if indexData != nil {
+			dataPoints = len(indexData.DataPoints)
+		}
+		log.Warnf("❗ Not enough data points to detect market regime, expected %d but got %d", m.Period, dataPoints)
+		return neutralAssessment(m.Name())
+	}
+
+	currentPrice := indexData.DataPoints[len(indexData.DataPoints)-1].Close
+	movingAverage := utils.CalculateMovingAverage(indexData.DataPoints, m.Period)
+	distance := currentPrice/movingAverage - 1
+	confidence := math.Min(1, 0.5+math.Abs(distance)*10)
+
+	if currentPrice > movingAverage {
+		log.Infof("📈 Market regime: Bull, current price: %.2f, %dMA: %.2f", currentPrice, m.Period, movingAverage)
+		return singleAssessment(m.Name(), Bull, confidence, distance)
+	}
+	log.Infof("📉 Market regime: Bear, current price: %.2f, %dMA: %.2f", currentPrice, m.Period, movingAverage)
+	return singleAssessment(m.Name(), Bear, confidence, distance)
+}
+
+// MASlopeRegimeModel looks at the direction of the index moving average: its change over
+// SlopePeriod days must exceed Threshold in either direction to call a trend.
+type MASlopeRegimeModel struct {
+	Period      int
+	SlopePeriod int
+	Threshold   float64
+}
+
+func (m MASlopeRegimeModel) Name() string { return "maSlope" }
+
+func (m MASlopeRegimeModel) Assess(indexData *datapb.StockResponse, _ map[string]*datapb.StockResponse) RegimeAssessment {
+	if indexData == nil || len(indexData.DataPoints) < m.Period+m.SlopePeriod {
+		return neutralAssessment(m.Name())
+	}
+
+	movingAverage := utils.SMA(utils.Closes(indexData.DataPoints), m.Period)
+	last := len(movingAverage) - 1
+	slope := movingAverage[last]/movingAverage[last-m.SlopePeriod] - 1
+	regime, confidence := classifyReading(slope, m.Threshold, -m.Threshold)
+	return singleAssessment(m.Name(), regime, confidence, slope)
+}
+
+// BreadthRegimeModel measures the fraction of the universe trading above its own moving average.
+type BreadthRegimeModel struct {
+	Period        int
+	BullThreshold float64
+	BearThreshold float64
+}
+
+func (m BreadthRegimeModel) Name() string { return "breadth" }
+
+func (m BreadthRegimeModel) Assess(_ *datapb.StockResponse, universe map[string]*datapb.StockResponse) RegimeAssessment {
+	evaluated, above := 0, 0
+	for _, data := range universe {
+		if data == nil || len(data.DataPoints) < m.Period {
+			continue
+		}
+		evaluated++
+		if data.DataPoints[len(data.DataPoints)-1].Close > utils.CalculateMovingAverage(data.DataPoints, m.Period) {
+			above++
+		}
+	}
+	if evaluated == 0 {
+		return neutralAssessment(m.Name())
+	}
+
+	breadth := float64(above) / float64(evaluated)
+	regime, confidence := classifyReading(breadth, m.BullThreshold, m.BearThreshold)
+	return singleAssessment(m.Name(), regime, confidence, breadth)
+}
+
+// VolatilityRegimeModel classifies by the annualized realized volatility of the index: calm
+// markets below LowVolatility are bullish and stressed markets above HighVolatility bearish.
+type VolatilityRegimeModel struct {
+	Period         int
+	LowVolatility  float64
+	HighVolatility float64
+}
+
+func (m VolatilityRegimeModel) Name() string { return "volatility" }
+
+func (m VolatilityRegimeModel) Assess(indexData *datapb.StockResponse, _ map[string]*datapb.StockResponse) RegimeAssessment {
+	if indexData == nil || len(indexData.DataPoints) <= m.Period {
+		return neutralAssessment(m.Name())
+	}
+
+	volatility := utils.Last(utils.RollingVolatility(utils.AdjustedCloses(indexData.DataPoints), m.Period))
+	if math.IsNaN(volatility) {
+		return neutralAssessment(m.Name())
+	}
+	regime, confidence := classifyReading(volatility, m.LowVolatility, m.HighVolatility)
+	return singleAssessment(m.Name(), regime, confidence, volatility)
+}
+
+// DrawdownRegimeModel classifies by the index drawdown from its high over Period days.
+type DrawdownRegimeModel struct {
+	Period          int
+	NeutralDrawdown float64
+	BearDrawdown    float64
+}
+
+func (m DrawdownRegimeModel) Name() string { return "drawdown" }
+
+func (m DrawdownRegimeModel) Assess(indexData *datapb.StockResponse, _ map[string]*datapb.StockResponse) RegimeAssessment {
+	if indexData == nil || len(indexData.DataPoints) == 0 {
+		return neutralAssessment(m.Name())
+	}
+
+	dataPoints := recentDataPoints(indexData.DataPoints, m.Period)
+	high := 0.0
+	for _, dp := range dataPoints {
+		high = math.Max(high, dp.Close)
+	}
+	drawdown := 1 - dataPoints[len(dataPoints)-1].Close/high
+	regime, confidence := classifyReading(drawdown, m.NeutralDrawdown, m.BearDrawdown)
+	return singleAssessment(m.Name(), regime, confidence, drawdown)
+}
+
+// CompositeRegimeModel combines models by a confidence-weighted vote (+1 Bull, 0 Neutral, -1 Bear).
+// Models without enough data abstain. An average vote above 1/3 is Bull and below -1/3 is Bear.
+type CompositeRegimeModel struct {
+	Models []RegimeModel
+}
+
+func (m CompositeRegimeModel) Name() string { return "composite" }
+
+func (m CompositeRegimeModel) Assess(indexData *datapb.StockResponse, universe map[string]*datapb.StockResponse) RegimeAssessment {
+	var signals []*pb.RegimeSignal
+	votes, voters := 0.0, 0
+	for _, model := range m.Models {
+		assessment := model.Assess(indexData, universe)
+		signals = append(signals, assessment.Signals...)
+		if assessment.Confidence == 0 {
+			continue
+		}
+		voters++
+		switch assessment.Regime {
+		case Bull:
+			votes += assessment.Confidence
+		case Bear:
+			votes -= assessment.Confidence
+		}
+	}
+	if voters == 0 {
+		return RegimeAssessment{Regime: Neutral, Signals: append(signals, neutralAssessment(m.Name()).Signals...)}
+	}
+
+	score := votes / float64(voters)
+	regime, confidence := classifyReading(score, 1.0/3, -1.0/3)
+	composite := singleAssessment(m.Name(), regime, confidence, score)
+	composite.Signals = append(signals, composite.Signals...)
+	log.Infof("🧭 Composite market regime: %s (score %.2f, confidence %.2f)", pb.MarketRegime(regime), score, confidence)
+	return composite
+}
+
+// RegimeAction is what a strategy does with its signals in a given regime.
+type RegimeAction string
+
+const (
+	RegimeFull      RegimeAction = "full"      // Trade the signals at full exposure
+	RegimeReduced   RegimeAction = "reduced"   // Trade the signals at ReducedExposure
+	RegimeNoNewBuys RegimeAction = "noNewBuys" // Downgrade BUY to HOLD so that only existing positions are kept
+	RegimeCash      RegimeAction = "cash"      // Emit no signals, which exits every position
+)
+
+// regimeActionValues maps each action to the value reported in signal responses.
+var regimeActionValues = map[RegimeAction]pb.RegimeAction{
+	RegimeFull:      pb.RegimeAction_FULL,
+	RegimeReduced:   pb.RegimeAction_REDUCED,
+	RegimeNoNewBuys: pb.RegimeAction_NO_NEW_BUYS,
+	RegimeCash:      pb.RegimeAction_CASH,
+}
+
+// regimeActionFromProto returns the action a signal response reports.
+func regimeActionFromProto(value pb.RegimeAction) RegimeAction {
+	for action, v := range regimeActionValues {
+		if v == value {
+			return action
+		}
+	}
+	return RegimeFull
+}
+
+var defaultCompositeRegimeModels = []string{"ma", "maSlope", "breadth", "volatility", "drawdown"}
+
+// RegimeConfig selects a regime model and the action taken in each regime. It is shared by the
+// strategies and exposed through the same parameter names everywhere.
+type RegimeConfig struct {
+	Model           string
+	Components      []string // Models combined by the composite model
+	MAPeriod        int
+	BullAction      RegimeAction
+	NeutralAction   RegimeAction
+	BearAction      RegimeAction
+	ReducedExposure float64
+}
+
+// DefaultRegimeConfig uses the index moving average, trades fully in a bull market, at half
+// exposure in a neutral one and moves to cash in a bear market. Setting BearAction to
+// RegimeNoNewBuys keeps existing positions through a bear market instead.
+func DefaultRegimeConfig() RegimeConfig {
+	return RegimeConfig{
+		Model:           "ma",
+		Components:      defaultCompositeRegimeModels,
+		MAPeriod:        200,
+		BullAction:      RegimeFull,
+		NeutralAction:   RegimeReduced,
+		BearAction:      RegimeCash,
+		ReducedExposure: 0.5,
+	}
+}
+
+// NewRegimeModel builds the configured model.
+func (c RegimeConfig) NewRegimeModel() (RegimeModel, error) {
+	if c.Model != "composite" {
+		return c.newSingleModel(c.Model)
+	}
+	if len(c.Components) == 0 {
+		return nil, fmt.Errorf("composite regime model needs at least one component")
+	}
+	composite := CompositeRegimeModel{}
+	for _, name := range c.Components {
+		model, err := c.newSingleModel(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		composite.Models = append(composite.Models, model)
+	}
+	return composite, nil
+}
+
+func (c RegimeConfig) newSingleModel(name string) (RegimeModel, error) {
+	switch name {
+	case "ma":
+		return MovingAverageRegimeModel{Period: c.MAPeriod}, nil
+	case "maSlope":
+		return MASlopeRegimeModel{Period: c.MAPeriod, SlopePeriod: 20, Threshold: 0.005}, nil
+	case "breadth":
+		return BreadthRegimeModel{Period: c.MAPeriod, BullThreshold: 0.6, BearThreshold: 0.4}, nil
+	case "volatility":
+		return VolatilityRegimeModel{Period: 20, LowVolatility: 0.15, HighVolatility: 0.25}, nil
+	case "drawdown":
+		return DrawdownRegimeModel{Period: 252, NeutralDrawdown: 0.1, BearDrawdown: 0.2}, nil
+	default:
+		return nil, fmt.Errorf("unknown regime model %q", name)
+	}
+}
+
+func (c RegimeConfig) validate() error {
+	if c.MAPeriod <= 0 {
+		return fmt.Errorf("marketRegimePeriod must be positive")
+	}
+	for _, action := range []RegimeAction{c.BullAction, c.NeutralAction, c.BearAction} {
+		switch action {
+		case RegimeFull, RegimeReduced, RegimeNoNewBuys, RegimeCash:
+		default:
+			return fmt.Errorf("regime action must be %q, %q, %q or %q, got %q", RegimeFull, RegimeReduced, RegimeNoNewBuys, RegimeCash, action)
+		}
+	}
+	if c.ReducedExposure <= 0 || c.ReducedExposure > 1 {
+		return fmt.Errorf("reducedExposure must be in (0, 1], got %v", c.ReducedExposure)
+	}
+	return nil
+}
+
+// Action returns the configured action for a regime.
+func (c RegimeConfig) Action(regime MarketRegime) RegimeAction {
+	switch regime {
+	case Bull:
+		return c.BullAction
+	case Bear:
+		return c.BearAction
+	default:
+		return c.NeutralAction
+	}
+}
+
+// Exposure returns the fraction of the portfolio invested under an action.
+func (c RegimeConfig) Exposure(action RegimeAction) float64 {
+	switch action {
+	case RegimeReduced:
+		return c.ReducedExposure
+	case RegimeCash:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// readRegimeModelParameters reads the parameters selecting the regime model into config.
+func readRegimeModelParameters(r *paramReader, config *RegimeConfig) {
+	r.String("regimeModel", &config.Model)
+	r.List("regimeModels", &config.Components)
+	r.Int("marketRegimePeriod", &config.MAPeriod)
+}
+
+// readRegimeParameters reads the regime model and per-regime action parameters into config.
+func readRegimeParameters(r *paramReader, config *RegimeConfig) {
+	readRegimeModelParameters(r, config)
+	bull, neutral, bear := string(config.BullAction), string(config.NeutralAction), string(config.BearAction)
+	r.String("bullAction", &bull)
+	r.String("neutralAction", &neutral)
+	r.String("bearAction", &bear)
+	r.Float("reducedExposure", &config.ReducedExposure)
+	config.BullAction, config.NeutralAction, config.BearAction = RegimeAction(bull), RegimeAction(neutral), RegimeAction(bear)
+}
+
+// regimeModelParameters adds the regime model parameters to params.
+func regimeModelParameters(config RegimeConfig, params map[string]interface{}) map[string]interface{} {
+	params["regimeModel"] = config.Model
+	params["regimeModels"] = strings.Join(config.Components, ",")
+	params["marketRegimePeriod"] = config.MAPeriod
+	return params
+}
+
+// regimeParameters adds the regime model and per-regime action parameters to params.
+func regimeParameters(config RegimeConfig, params map[string]interface{}) map[string]interface{} {
+	params["bullAction"] = string(config.BullAction)
+	params["neutralAction"] = string(config.NeutralAction)
+	params["bearAction"] = string(config.BearAction)
+	params["reducedExposure"] = config.ReducedExposure
+	return regimeModelParameters(config, params)
+}
+
+// regimeModelParameterSchema describes the regime model parameters.
+func regimeModelParameterSchema(config RegimeConfig) []ParameterSpec {
+	return []ParameterSpec{
+		{Name: "regimeModel", Type: StringParameter, Default: config.Model, Description: "ma, maSlope, breadth, volatility, drawdown or composite"},
+		{Name: "regimeModels", Type: ListParameter, Default: strings.Join(config.Components, ","), Description: "Models combined by the composite regime model"},
+		{Name: "marketRegimePeriod", Type: IntParameter, Default: config.MAPeriod, Min: 50, Max: 300, Description: "Moving average period of the regime models"},
+	}
+}
+
+// regimeParameterSchema describes the regime model and per-regime action parameters.
+func regimeParameterSchema(config RegimeConfig) []ParameterSpec {
+	return append(regimeModelParameterSchema(config), []ParameterSpec{
+		{Name: "bullAction", Type: StringParameter, Default: string(config.BullAction), Description: "Action in a bull market: full, reduced, noNewBuys or cash"},
+		{Name: "neutralAction", Type: StringParameter, Default: string(config.NeutralAction), Description: "Action in a neutral market: full, reduced, noNewBuys or cash"},
+		{Name: "bearAction", Type: StringParameter, Default: string(config.BearAction), Description: "Action in a bear market: full, reduced, noNewBuys or cash"},
+		{Name: "reducedExposure", Type: FloatParameter, Default: config.ReducedExposure, Min: 0.05, Max: 1, Description: "Fraction invested under the reduced action"},
+	}...)
+}
+
+// applyRegimeAction adjusts the selected signals for the action: noNewBuys downgrades BUY to HOLD
+// and cash drops every signal.
+func applyRegimeAction(signals []*pb.StockSignal, action RegimeAction) []*pb.StockSignal {
+	switch action {
+	case RegimeCash:
+		return []*pb.StockSignal{}
+	case RegimeNoNewBuys:
+		for _, signal := range signals {
+			if signal.Signal == pb.SignalType_BUY {
+				signal.Signal = pb.SignalType_HOLD
+			}
+		}
+	}
+	return signals
+}
+
+// setRegime records the assessment, the action taken and the resulting exposure on a response.
+func setRegime(resp *pb.SignalResponse, assessment RegimeAssessment, action RegimeAction, exposure float64) *pb.SignalResponse {
+	resp.MarketRegime = pb.MarketRegime(assessment.Regime)
+	resp.RegimeConfidence = assessment.Confidence
+	resp.RegimeSignals = assessment.Signals
+	resp.RegimeAction = regimeActionValues[action]
+	resp.TargetExposure = &exposure
+	return resp
+}
@@ -0,0 +1,189 @@
+package strategy
+
+import (
+	"fmt"
+	"math"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+	pb "momentum-trading-platform/api/proto/strategy_service"
+	"momentum-trading-platform/internal/utils"
+)
+
+// Risk methods turn a stock's price history into a risk unit. The portfolio service allocates
+// capital in proportion to risk units, so only their ratios between stocks matter.
+const (
+	riskMethodATR                   = "atr"                   // riskFactor / ATR, the original sizing
+	riskMethodATRPercent            = "atrPercent"            // riskFactor / (ATR / price)
+	riskMethodInverseVolatility     = "inverseVolatility"     // targetVolatility / realized volatility
+	riskMethodEqualRiskContribution = "equalRiskContribution" // weights equalizing each position's share of variance
+	riskMethodFixedFractional       = "fixedFractional"       // riskFraction / stop distance, capped at the whole book
+	riskMethodEqual                 = "equal"                 // the same unit for every stock
+
+	// riskMethodBlended marks ensemble signals whose unit is the weighted sum of the children's scaled units.
+	riskMethodBlended = "blended"
+)
+
+var riskMethods = []string{
+	riskMethodATR, riskMethodATRPercent, riskMethodInverseVolatility,
+	riskMethodEqualRiskContribution, riskMethodFixedFractional, riskMethodEqual,
+}
+
+// RiskConfig selects how a strategy sizes positions. Period is the ATR or volatility lookback.
+type RiskConfig struct {
+	Method           string
+	Period           int
+	RiskFactor       float64
+	TargetVolatility float64
+	RiskFraction     float64 // Equity risked per position by fixedFractional
+	StopMultiple     float64 // ATRs between entry and stop for fixedFractional
+}
+
+func DefaultRiskConfig() RiskConfig {
+	return RiskConfig{
+		Method:           riskMethodATR,
+		Period:           20,
+		RiskFactor:       0.001,
+		TargetVolatility: 0.1,
+		RiskFraction:     0.01,
+		StopMultiple:     2,
+	}
+}
+
+func (c RiskConfig) validate() error {
+	known := false
+	for _, method := range riskMethods {
+		known = known || method == c.Method
+	}
+	if !known {
+		return fmt.Errorf("riskMethod must be one of %v, got %q", riskMethods, c.Method)
+	}
+	if c.Period < 2 {
+		return fmt.Errorf("risk period must be at least 2")
+	}
+	if c.RiskFactor <= 0 || c.TargetVolatility <= 0 || c.RiskFraction <= 0 || c.StopMultiple <= 0 {
+		return fmt.Errorf("riskFactor, targetVolatility, riskFraction and stopMultiple must be positive")
+	}
+	return nil
+}
+
+// riskUnit returns the risk unit of a stock together with the metric it was derived from. A stock
+// whose metric cannot be measured gets a zero unit and therefore no allocation.
+func (c RiskConfig) riskUnit(dataPoints []*datapb.StockDataPoint) (unit, metric float64) {
+	if len(dataPoints) == 0 {
+		return 0, 0
+	}
+	price := dataPoints[len(dataPoints)-1].Close
+
+	switch c.Method {
+	case riskMethodEqual:
+		return 1, 0
+	case riskMethodInverseVolatility, riskMethodEqualRiskContribution:
+		// Equal risk contribution starts from inverse volatility and is refined across the
+		// selection by allocate
+		metric = utils.CalculateVolatility(dataPoints, c.Period)
+		return safeDivide(c.TargetVolatility, metric), metric
+	case riskMethodATRPercent:
+		if price > 0 {
+			metric = utils.CalculateATR(dataPoints, c.Period) / price
+		}
+		return safeDivide(c.RiskFactor, metric), metric
+	case riskMethodFixedFractional:
+		if price > 0 {
+			metric = c.StopMultiple * utils.CalculateATR(dataPoints, c.Period) / price
+		}
+		if metric == 0 {
+			return 0, 0
+		}
+		return math.Min(c.RiskFraction/metric, 1), metric
+	default:
+		metric = utils.CalculateATR(dataPoints, c.Period)
+		return safeDivide(c.RiskFactor, metric), metric
+	}
+}
+
+// applyRisk sets the risk unit, method and metric of a signal.
+func (c RiskConfig) applyRisk(signal *pb.StockSignal, dataPoints []*datapb.StockDataPoint) {
+	signal.RiskUnit, signal.RiskMetric = c.riskUnit(dataPoints)
+	signal.RiskMethod = c.Method
+}
+
+// allocate refines the risk units of the signals that will be held once the selection is known.
+// Only equal risk contribution depends on the other positions; it replaces each unit with the
+// weight that equalizes the positions' contributions to portfolio variance.
+func (c RiskConfig) allocate(signals []*pb.StockSignal, batchStockData map[string]*datapb.StockResponse) {
+	if c.Method != riskMethodEqualRiskContribution {
+		return
+	}
+
+	var held []*pb.StockSignal
+	var returns [][]float64
+	for _, signal := range signals {
+		if signal.Signal == pb.SignalType_SELL || signal.RiskUnit == 0 {
+			continue
+		}
+		data, ok := batchStockData[signal.Symbol]
+		if !ok || len(data.DataPoints) <= c.Period {
+			continue
+		}
+		held = append(held, signal)
+		returns = append(returns, recentReturns(data.DataPoints, c.Period))
+	}
+	if len(held) < 2 {
+		return
+	}
+
+	weights := utils.RiskParityWeights(utils.CovarianceMatrix(returns))
+	for i, signal := range held {
+		// Scale so that units stay comparable in size to the inverse volatility units
+		signal.RiskUnit = weights[i] * float64(len(held))
+	}
+}
+
+// recentReturns returns the last period daily log returns of adjusted closes.
+func recentReturns(dataPoints []*datapb.StockDataPoint, period int) []float64 {
+	data := dataPoints[len(dataPoints)-period-1:]
+	returns := make([]float64, period)
+	for i := 1; i < len(data); i++ {
+		returns[i-1] = math.Log(data[i].AdjustedClose / data[i-1].AdjustedClose)
+	}
+	return returns
+}
+
+func safeDivide(numerator, denominator float64) float64 {
+	if denominator == 0 {
+		return 0
+	}
+	return numerator / denominator
+}
+
+// readRiskParameters reads the risk parameters. Strategies name the lookback themselves
+// (riskPeriod, atrPeriod or volatilityPeriod) to keep their existing parameter names.
+func readRiskParameters(r *paramReader, c *RiskConfig, periodKey string) {
+	r.String("riskMethod", &c.Method)
+	r.Int(periodKey, &c.Period)
+	r.Float("riskFactor", &c.RiskFactor)
+	r.Float("targetVolatility", &c.TargetVolatility)
+	r.Float("riskFraction", &c.RiskFraction)
+	r.Float("stopMultiple", &c.StopMultiple)
+}
+
+func riskParameters(c RiskConfig, periodKey string, params map[string]interface{}) map[string]interface{} {
+	params["riskMethod"] = c.Method
+	params[periodKey] = c.Period
+	params["riskFactor"] = c.RiskFactor
+	params["targetVolatility"] = c.TargetVolatility
+	params["riskFraction"] = c.RiskFraction
+	params["stopMultiple"] = c.StopMultiple
+	return params
+}
+
+func riskParameterSchema(defaults RiskConfig, periodKey string) []ParameterSpec {
+	return []ParameterSpec{
+		{Name: "riskMethod", Type: StringParameter, Default: defaults.Method, Description: "atr, atrPercent, inverseVolatility, equalRiskContribution, fixedFractional or equal"},
+		{Name: periodKey, Type: IntParameter, Default: defaults.Period, Min: 2, Max: 252, Description: "Days used for ATR or volatility in risk units"},
+		{Name: "riskFactor", Type: FloatParameter, Default: defaults.RiskFactor, Min: 0.0001, Max: 0.01, Description: "Risk factor divided by ATR or ATR percent to size positions"},
+		{Name: "targetVolatility", Type: FloatParameter, Default: defaults.TargetVolatility, Min: 0.01, Max: 0.5, Description: "Annualized volatility each position is scaled to"},
+		{Name: "riskFraction", Type: FloatParameter, Default: defaults.RiskFraction, Min: 0.001, Max: 0.1, Description: "Fraction of equity lost at the stop in fixedFractional"},
+		{Name: "stopMultiple", Type: FloatParameter, Default: defaults.StopMultiple, Min: 0.5, Max: 10, Description: "Stop distance in ATRs for fixedFractional"},
+	}
+}
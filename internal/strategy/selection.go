@@ -0,0 +1,170 @@
+package strategy
+
+import (
+	"fmt"
+	"sort"
+
+	pb "momentum-trading-platform/api/proto/strategy_service"
+)
+
+const (
+	selectionModeTopPercent = "topPercent"
+	selectionModeTopN       = "topN"
+
+	rankingModeGlobal = "global"
+	rankingModeSector = "sector"
+
+	// unknownSector groups symbols without sector metadata in diagnostics.
+	unknownSector = "UNKNOWN"
+)
+
+// SelectionConfig decides how many of the ranked candidates are selected and how sectors constrain
+// the choice. In topPercent mode the count is TopPercentage of the candidates but never fewer than
+// MinCount; in topN mode it is TopN. SectorMax caps the names taken from one sector, and the
+// sector ranking mode takes the best name of every sector before the second best of any.
+type SelectionConfig struct {
+	Mode          string
+	TopPercentage float64
+	MinCount      int
+	TopN          int
+	SectorMax     int // 0 disables the cap
+	RankingMode   string
+}
+
+func DefaultSelectionConfig() SelectionConfig {
+	return SelectionConfig{
+		Mode:          selectionModeTopPercent,
+		TopPercentage: 0.2,
+		MinCount:      1,
+		TopN:          10,
+		RankingMode:   rankingModeGlobal,
+	}
+}
+
+func (c SelectionConfig) validate() error {
+	switch c.Mode {
+	case selectionModeTopPercent:
+		if c.TopPercentage <= 0 || c.TopPercentage > 1 {
+			return fmt.Errorf("topPercentage must be in (0, 1], got %v", c.TopPercentage)
+		}
+		if c.MinCount < 0 {
+			return fmt.Errorf("minCount must not be negative")
+		}
+	case selectionModeTopN:
+		if c.TopN <= 0 {
+			return fmt.Errorf("topN must be positive")
+		}
+	default:
+		return fmt.Errorf("selectionMode must be %q or %q, got %q", selectionModeTopPercent, selectionModeTopN, c.Mode)
+	}
+	if c.SectorMax < 0 {
+		return fmt.Errorf("sectorMax must not be negative")
+	}
+	if c.RankingMode != rankingModeGlobal && c.RankingMode != rankingModeSector {
+		return fmt.Errorf("rankingMode must be %q or %q, got %q", rankingModeGlobal, rankingModeSector, c.RankingMode)
+	}
+	return nil
+}
+
+// needsMetadata reports whether selection depends on sector metadata.
+func (c SelectionConfig) needsMetadata() bool {
+	return c.SectorMax > 0 || c.RankingMode == rankingModeSector
+}
+
+// count returns how many of n candidates to select.
+func (c SelectionConfig) count(n int) int {
+	if c.Mode == selectionModeTopN {
+		return min(c.TopN, n)
+	}
+	return min(max(int(float64(n)*c.TopPercentage), c.MinCount), n)
+}
+
+// selectionResult is the outcome of selecting from ranked candidates.
+type selectionResult struct {
+	ranked      []*pb.StockSignal // every candidate in selection order
+	selected    []*pb.StockSignal
+	sectorRanks map[string]int
+	limited     map[string]*pb.FilterResult // candidates skipped by the sector cap
+}
+
+// selectSignals ranks the candidates and picks the selection. Sectors maps symbols to their sector;
+// symbols without a known sector are ranked together under UNKNOWN but are not capped, since the
+// cap would otherwise collapse an unclassified universe into a handful of names.
+func (c SelectionConfig) selectSignals(signals []*pb.StockSignal, sectors map[string]string) selectionResult {
+	rankByMomentum(signals)
+
+	result := selectionResult{
+		sectorRanks: make(map[string]int, len(signals)),
+		limited:     make(map[string]*pb.FilterResult),
+	}
+	sectorCounts := make(map[string]int)
+	for _, signal := range signals {
+		sector := sectorOf(sectors, signal.Symbol)
+		sectorCounts[sector]++
+		result.sectorRanks[signal.Symbol] = sectorCounts[sector]
+	}
+
+	result.ranked = signals
+	if c.RankingMode == rankingModeSector {
+		result.ranked = make([]*pb.StockSignal, len(signals))
+		copy(result.ranked, signals)
+		// Stable sort keeps the global order among names with the same sector rank
+		sort.SliceStable(result.ranked, func(i, j int) bool {
+			return result.sectorRanks[result.ranked[i].Symbol] < result.sectorRanks[result.ranked[j].Symbol]
+		})
+	}
+
+	target := c.count(len(signals))
+	taken := make(map[string]int)
+	for _, signal := range result.ranked {
+		if len(result.selected) == target {
+			break
+		}
+		sector, known := sectors[signal.Symbol]
+		if known && sector != "" && c.SectorMax > 0 && taken[sector] >= c.SectorMax {
+			result.limited[signal.Symbol] = fail("sectorMax", ReasonSectorLimit,
+				fmt.Sprintf("already holding %d names from %s", c.SectorMax, sector))
+			continue
+		}
+		taken[sector]++
+		result.selected = append(result.selected, signal)
+	}
+	return result
+}
+
+func sectorOf(sectors map[string]string, symbol string) string {
+	if sector := sectors[symbol]; sector != "" {
+		return sector
+	}
+	return unknownSector
+}
+
+func readSelectionParameters(r *paramReader, c *SelectionConfig) {
+	r.String("selectionMode", &c.Mode)
+	r.Float("topPercentage", &c.TopPercentage)
+	r.Int("minCount", &c.MinCount)
+	r.Int("topN", &c.TopN)
+	r.Int("sectorMax", &c.SectorMax)
+	r.String("rankingMode", &c.RankingMode)
+}
+
+func selectionParameters(c SelectionConfig, params map[string]interface{}) map[string]interface{} {
+	params["selectionMode"] = c.Mode
+	params["topPercentage"] = c.TopPercentage
+	params["minCount"] = c.MinCount
+	params["topN"] = c.TopN
+	params["sectorMax"] = c.SectorMax
+	params["rankingMode"] = c.RankingMode
+	return params
+}
+
+func selectionParameterSchema(defaults SelectionConfig) []ParameterSpec {
+	return []ParameterSpec{
+		{Name: "selectionMode", Type: StringParameter, Default: defaults.Mode, Description: "topPercent or topN"},
+		{Name: "topPercentage", Type: FloatParameter, Default: defaults.TopPercentage, Min: 0.01, Max: 1, Description: "Fraction of qualified stocks to select in topPercent mode"},
+		{Name: "minCount", Type: IntParameter, Default: defaults.MinCount, Min: 0, Max: 500, Description: "Fewest stocks selected in topPercent mode"},
+		{Name: "topN", Type: IntParameter, Default: defaults.TopN, Min: 1, Max: 500, Description: "Stocks selected in topN mode"},
+		{Name: "sectorMax", Type: IntParameter, Default: defaults.SectorMax, Min: 0, Max: 500, Description: "Most stocks selected from one sector, 0 disables the cap"},
+		{Name: "rankingMode", Type: StringParameter, Default: defaults.RankingMode, Description: "global, or sector to take each sector's leaders first"},
+	}
+}
@@ -2,10 +2,15 @@ package strategy
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	pb "momentum-trading-platform/api/proto/strategy_service"
+	"momentum-trading-platform/internal/utils"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Server struct {
@@ -13,9 +18,13 @@ type Server struct {
 	Logger     *log.Logger
 	Clients    *Clients
 	Strategies map[string]Strategy
+	DB         *sql.DB
+	mu         sync.RWMutex // guards Strategies, which grows when strategies are defined at runtime
+	cache      *signalCache
+	metadata   MetadataProvider
 }
 
-func NewServer(clients *Clients) *Server {
+func NewServer(clients *Clients, db *sql.DB) (*Server, error) {
 	logger := log.New()
 	logger.SetLevel(log.TraceLevel)
 	logger.SetFormatter(&log.TextFormatter{
@@ -26,12 +35,26 @@ func NewServer(clients *Clients) *Server {
 		Logger:     logger,
 		Clients:    clients,
 		Strategies: make(map[string]Strategy),
+		DB:         db,
+		cache:      newSignalCache(),
 	}
 
-	// Register the momentum strategy
-	s.Strategies["momentum"] = NewMomentumStrategy()
+	if clients != nil && clients.DataClient != nil {
+		s.metadata = utils.NewSectorCache(clients.DataClient)
+	}
+
+	// Register every known strategy with its default parameters
+	for _, name := range StrategyNames() {
+		strategy, _ := NewStrategy(name)
+		setMetadataProvider(strategy, s.metadata)
+		s.Strategies[name] = strategy
+	}
 
-	return s
+	if err := s.initDatabase(); err != nil {
+		return nil, fmt.Errorf("failed to initialize database: %v", err)
+	}
+
+	return s, nil
 }
 
 func (s *Server) ConfigureStrategy(ctx context.Context, req *pb.ConfigureStrategyRequest) (*pb.ConfigureStrategyResponse, error) {
@@ -65,21 +88,81 @@ func (s *Server) GetStrategyParameters(ctx context.Context, req *pb.GetStrategyP
 		stringParams[k] = fmt.Sprintf("%v", v)
 	}
 
+	strategy, _ := s.getStrategy(req.StrategyName)
+	var schema []*pb.ParameterSpec
+	for _, spec := range strategy.ParameterSchema() {
+		schema = append(schema, &pb.ParameterSpec{
+			Name:         spec.Name,
+			Type:         string(spec.Type),
+			DefaultValue: fmt.Sprintf("%v", spec.Default),
+			Min:          spec.Min,
+			Max:          spec.Max,
+			Description:  spec.Description,
+		})
+	}
+
 	return &pb.GetStrategyParametersResponse{
 		Parameters: stringParams,
+		Schema:     schema,
 	}, nil
 }
 
+// ValidateStrategy checks parameters and a universe the way a signal request with them would,
+// without generating signals, so that other services can reject a configuration up front.
+func (s *Server) ValidateStrategy(ctx context.Context, req *pb.ValidateStrategyRequest) (*pb.ValidateStrategyResponse, error) {
+	strategy, err := s.requestStrategy(req.StrategyName, req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	symbols := scoredUniverse(strategy, req.Symbols)
+	if len(symbols) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "strategy %s has no default universe, symbols are required", req.StrategyName)
+	}
+	return &pb.ValidateStrategyResponse{Universe: symbols}, nil
+}
+
+// configureStrategy applies parameters to a registered strategy by replacing it with a new
+// instance, so that signal requests already running keep the instance they started with. A strategy
+// that is not registered yet is created from a "definition" parameter holding its YAML definition,
+// which is validated first.
 func (s *Server) configureStrategy(strategyName string, params map[string]interface{}) error {
-	strategy, ok := s.Strategies[strategyName]
-	if !ok {
+	// Holding the lock while the instance is built keeps concurrent updates from overwriting each other
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.Strategies[strategyName]
+	_, hasDefinition := params["definition"]
+	if !ok && !hasDefinition {
 		return fmt.Errorf("strategy %s not found", strategyName)
 	}
+	if _, declarative := current.(*DeclarativeStrategy); ok && hasDefinition && !declarative {
+		return fmt.Errorf("strategy %s is built in and does not accept a definition", strategyName)
+	}
 
-	err := strategy.SetParameters(params)
+	// A definition replaces the whole strategy; otherwise the parameters update the current ones
+	merged := params
+	if ok && !hasDefinition {
+		merged = current.GetParameters()
+		for k, v := range params {
+			merged[k] = v
+		}
+	}
+
+	strategy, err := NewStrategyFromParameters(strategyName, merged)
 	if err != nil {
+		if !ok {
+			return fmt.Errorf("failed to define strategy %s: %v", strategyName, err)
+		}
 		return fmt.Errorf("failed to set parameters for strategy %s: %v", strategyName, err)
 	}
+	setMetadataProvider(strategy, s.metadata)
+	if hasDefinition {
+		if err := registerDeclarativeStrategy(strategyName, strategy); err != nil {
+			return err
+		}
+	}
+	s.Strategies[strategyName] = strategy
+	s.cache.invalidate(strategyName)
 
 	s.Logger.WithFields(log.Fields{
 		"strategy": strategyName,
@@ -89,8 +172,26 @@ func (s *Server) configureStrategy(strategyName string, params map[string]interf
 	return nil
 }
 
-func (s *Server) getStrategyParameters(strategyName string) (map[string]interface{}, error) {
+// registerDeclarativeStrategy publishes the definition of a declarative strategy so that ensembles
+// of this service can select it by name. The definition lives in this process only.
+func registerDeclarativeStrategy(strategyName string, strategy Strategy) error {
+	declarative, ok := strategy.(*DeclarativeStrategy)
+	if !ok {
+		return fmt.Errorf("strategy %s is built in and does not accept a definition", strategyName)
+	}
+	def := declarative.Definition()
+	return RegisterStrategyDefinition(&def)
+}
+
+func (s *Server) getStrategy(strategyName string) (Strategy, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	strategy, ok := s.Strategies[strategyName]
+	return strategy, ok
+}
+
+func (s *Server) getStrategyParameters(strategyName string) (map[string]interface{}, error) {
+	strategy, ok := s.getStrategy(strategyName)
 	if !ok {
 		return nil, fmt.Errorf("strategy %s not found", strategyName)
 	}
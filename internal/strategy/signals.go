@@ -7,6 +7,9 @@ import (
 	pb "momentum-trading-platform/api/proto/strategy_service"
 
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func (s *Server) GenerateSignals(ctx context.Context, req *pb.SignalRequest) (*pb.SignalResponse, error) {
@@ -18,37 +21,138 @@ func (s *Server) GenerateSignals(ctx context.Context, req *pb.SignalRequest) (*p
 		"marketIndex": req.MarketIndex,
 	}).Info("Generating signals")
 
+	return s.generateSignals(ctx, req, true)
+}
+
+// GetSignalDiagnostics runs the strategy for the request and returns the per-symbol diagnostics
+// explaining why each stock was or was not selected. Diagnostics are read-only, so the run is not
+// recorded.
+func (s *Server) GetSignalDiagnostics(ctx context.Context, req *pb.SignalRequest) (*pb.SignalDiagnosticsResponse, error) {
+	s.Logger.WithFields(log.Fields{
+		"symbols":     req.Symbols,
+		"start":       req.StartDate,
+		"end":         req.EndDate,
+		"marketIndex": req.MarketIndex,
+	}).Info("Generating signal diagnostics")
+
+	resp, err := s.generateSignals(ctx, req, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SignalDiagnosticsResponse{
+		MarketRegime: resp.MarketRegime,
+		Diagnostics:  resp.Diagnostics,
+	}, nil
+}
+
+// generateSignals runs the requested strategy, recording the run in the signal history when record
+// is set.
+func (s *Server) generateSignals(ctx context.Context, req *pb.SignalRequest, record bool) (*pb.SignalResponse, error) {
+	strategyName := req.StrategyName
+	if strategyName == "" {
+		strategyName = "momentum"
+	}
+	strategy, err := s.requestStrategy(strategyName, req.StrategyParameters)
+	if err != nil {
+		return nil, err
+	}
+
 	// Fetch market index data (e.g., S&P 500)
 	indexResp, err := s.fetchIndexData(ctx, req.MarketIndex, req.StartDate, req.EndDate, req.Interval)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch index data: %v", err)
 	}
 
-	batchResp, err := s.fetchBatchStockData(ctx, req)
+	symbols := scoredUniverse(strategy, req.Symbols)
+	if provider, ok := strategy.(SymbolProvider); ok {
+		symbols = mergeSymbols(symbols, provider.RequiredSymbols())
+	}
+
+	batchResp, err := s.fetchBatchStockData(ctx, req, symbols)
 	if err != nil {
 		return nil, err
 	}
 
-	// Use the momentum strategy (or allow selection of strategy in the future)
-	strategy := s.Strategies["momentum"]
-	signals, err := strategy.GenerateSignals(batchResp.StockData, indexResp)
+	cacheKey := signalCacheKey(strategyName, strategy.GetParameters(), batchResp.StockData, indexResp)
+	// The cache holds its own copy because the response is stamped with a run id below
+	resp, cached := s.cache.get(cacheKey)
+	if cached {
+		s.Logger.WithField("strategy", strategyName).Debug("♻️ Serving signals computed for the same data and parameters")
+		resp = proto.Clone(resp).(*pb.SignalResponse)
+	} else {
+		resp, err = strategy.GenerateSignals(batchResp.StockData, indexResp)
+		if err != nil {
+			return nil, err
+		}
+		s.cache.put(cacheKey, strategyName, proto.Clone(resp).(*pb.SignalResponse))
+	}
+
+	if record {
+		// A failure to record the run should not prevent signals from being served
+		if err := s.recordSignalRun(strategyName, strategy, req, symbols, resp); err != nil {
+			s.Logger.WithError(err).Error("❌ Failed to record signal run")
+		}
+	}
+
+	return resp, nil
+}
+
+// requestStrategy returns the configured strategy, or a separate instance with the request's
+// parameters applied over the configured ones so that the configured strategy is left as is.
+func (s *Server) requestStrategy(strategyName string, overrides map[string]string) (Strategy, error) {
+	strategy, ok := s.getStrategy(strategyName)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "strategy %s not found", strategyName)
+	}
+	if len(overrides) == 0 {
+		return strategy, nil
+	}
+
+	params := strategy.GetParameters()
+	for k, v := range overrides {
+		params[k] = v
+	}
+	instance, err := NewStrategyFromParameters(strategyName, params)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parameters for strategy %s: %v", strategyName, err)
 	}
+	setMetadataProvider(instance, s.metadata)
+	return instance, nil
+}
 
-	return &pb.SignalResponse{
-		Signals: signals,
-	}, nil
+// scoredUniverse returns the symbols a strategy scores: the requested ones, or its default universe
+// when none are requested.
+func scoredUniverse(strategy Strategy, symbols []string) []string {
+	if provider, ok := strategy.(UniverseProvider); ok && len(symbols) == 0 {
+		return provider.DefaultUniverse()
+	}
+	return symbols
+}
+
+// mergeSymbols appends extra symbols that are not already present.
+func mergeSymbols(symbols, extra []string) []string {
+	seen := make(map[string]bool, len(symbols))
+	merged := make([]string, 0, len(symbols)+len(extra))
+	for _, list := range [][]string{symbols, extra} {
+		for _, symbol := range list {
+			if !seen[symbol] {
+				seen[symbol] = true
+				merged = append(merged, symbol)
+			}
+		}
+	}
+	return merged
 }
 
-func (s *Server) fetchBatchStockData(ctx context.Context, req *pb.SignalRequest) (*datapb.BatchStockResponse, error) {
+func (s *Server) fetchBatchStockData(ctx context.Context, req *pb.SignalRequest, symbols []string) (*datapb.BatchStockResponse, error) {
 	batchReq := &datapb.BatchStockRequest{
-		Symbols:   req.Symbols,
+		Symbols:   symbols,
 		StartDate: req.StartDate,
 		EndDate:   req.EndDate,
 		Interval:  req.Interval,
 	}
-	s.Logger.Infof("📡 Fetching following stock data: %v for %v to %v", req.Symbols, req.StartDate, req.EndDate)
+	s.Logger.Infof("📡 Fetching following stock data: %v for %v to %v", symbols, req.StartDate, req.EndDate)
 
 	batchResp, err := s.Clients.DataClient.GetBatchStockData(ctx, batchReq)
 	if err != nil {
@@ -1,6 +1,10 @@
 package strategy
 
 import (
+	"fmt"
+	"sort"
+	"sync"
+
 	datapb "momentum-trading-platform/api/proto/data_service"
 	pb "momentum-trading-platform/api/proto/strategy_service"
 )
@@ -13,10 +17,160 @@ const (
 	Neutral
 )
 
+// Strategy generates trading signals from market data.
 type Strategy interface {
-	GenerateSignals(stockData map[string]*datapb.StockResponse, indexData *datapb.StockResponse) ([]*pb.StockSignal, error)
+	GenerateSignals(stockData map[string]*datapb.StockResponse, indexData *datapb.StockResponse) (*pb.SignalResponse, error)
 	CalculateRisk(stockData *datapb.StockResponse) float64
 	GetParameters() map[string]interface{}
 	SetParameters(params map[string]interface{}) error
-	DetectMarketRegime(marketIndexData *datapb.StockResponse) MarketRegime
+	DetectMarketRegime(marketIndexData *datapb.StockResponse, universe map[string]*datapb.StockResponse) RegimeAssessment
+	ParameterSchema() []ParameterSpec
+}
+
+type ParameterType string
+
+const (
+	IntParameter    ParameterType = "int"
+	FloatParameter  ParameterType = "float"
+	StringParameter ParameterType = "string"
+	ListParameter   ParameterType = "list" // Comma-separated values
+)
+
+// ParameterSpec describes a tunable strategy parameter. Min and Max bound numeric parameters
+// and are both zero when the parameter is unbounded or not numeric.
+type ParameterSpec struct {
+	Name        string
+	Type        ParameterType
+	Default     interface{}
+	Min         float64
+	Max         float64
+	Description string
+}
+
+// SymbolProvider is implemented by strategies that need series beyond the requested universe,
+// such as a cash proxy or a defensive asset. The server fetches them alongside the universe.
+type SymbolProvider interface {
+	RequiredSymbols() []string
+}
+
+// UniverseProvider is implemented by strategies that trade a fixed universe. The server uses it
+// when a signal request does not name any symbols.
+type UniverseProvider interface {
+	DefaultUniverse() []string
+}
+
+// strategyFactories maps strategy names to constructors so that a strategy can be selected
+// by name for both live signals and backtests. Declarative strategies are added at runtime.
+var (
+	strategyFactories = map[string]func() Strategy{
+		"momentum":       func() Strategy { return NewMomentumStrategy() },
+		"dualMomentum":   func() Strategy { return NewDualMomentumStrategy() },
+		"meanReversion":  func() Strategy { return NewMeanReversionStrategy() },
+		"trendFollowing": func() Strategy { return NewTrendFollowingStrategy() },
+		"ensemble":       func() Strategy { return NewEnsembleStrategy() },
+	}
+	declarativeStrategies = make(map[string]bool)
+	factoriesMu           sync.RWMutex
+)
+
+// NewStrategy creates a strategy with default parameters by name.
+func NewStrategy(name string) (Strategy, error) {
+	factoriesMu.RLock()
+	factory, ok := strategyFactories[name]
+	factoriesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("strategy %s not found", name)
+	}
+	return factory(), nil
+}
+
+// NewStrategyFromParameters creates a strategy by name and applies the parameters. A strategy
+// that is not registered can be created from a "definition" parameter holding its YAML definition.
+func NewStrategyFromParameters(name string, params map[string]interface{}) (Strategy, error) {
+	strategy, err := NewStrategy(name)
+	if err != nil {
+		source, ok, _ := stringParam(params, "definition")
+		if !ok || source == "" {
+			return nil, err
+		}
+		def, err := ParseStrategyDefinition([]byte(source))
+		if err != nil {
+			return nil, err
+		}
+		if def.Name != name {
+			return nil, fmt.Errorf("definition name %q does not match strategy %q", def.Name, name)
+		}
+		strategy, err = NewDeclarativeStrategy(def)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := strategy.SetParameters(params); err != nil {
+		return nil, err
+	}
+	return strategy, nil
+}
+
+// RegisterStrategyDefinition makes a declarative strategy available by name, replacing an
+// earlier definition with the same name. Built-in strategies cannot be replaced.
+func RegisterStrategyDefinition(def *StrategyDefinition) error {
+	if _, err := NewDeclarativeStrategy(def); err != nil {
+		return err
+	}
+
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	if _, exists := strategyFactories[def.Name]; exists && !declarativeStrategies[def.Name] {
+		return fmt.Errorf("strategy %s is built in and cannot be redefined", def.Name)
+	}
+	definition := *def
+	strategyFactories[def.Name] = func() Strategy {
+		strategy, _ := NewDeclarativeStrategy(&definition)
+		return strategy
+	}
+	declarativeStrategies[def.Name] = true
+	return nil
+}
+
+// StrategyNames returns the names of all registered strategies in alphabetical order.
+func StrategyNames() []string {
+	factoriesMu.RLock()
+	names := make([]string, 0, len(strategyFactories))
+	for name := range strategyFactories {
+		names = append(names, name)
+	}
+	factoriesMu.RUnlock()
+	sort.Strings(names)
+	return names
+}
+
+// rankByMomentum sorts signals by descending momentum score. Ties are broken by symbol so that
+// the ranking does not depend on map iteration order.
+func rankByMomentum(signals []*pb.StockSignal) {
+	sort.Slice(signals, func(i, j int) bool {
+		if signals[i].MomentumScore != signals[j].MomentumScore {
+			return signals[i].MomentumScore > signals[j].MomentumScore
+		}
+		return signals[i].Symbol < signals[j].Symbol
+	})
+}
+
+// sortDiagnostics returns the diagnostics ordered by rank, with disqualified symbols last in alphabetical order.
+func sortDiagnostics(diagnostics map[string]*pb.SignalDiagnostic) []*pb.SignalDiagnostic {
+	sorted := make([]*pb.SignalDiagnostic, 0, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		sorted = append(sorted, diagnostic)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		ri, rj := sorted[i].Rank, sorted[j].Rank
+		if ri != rj && ri != 0 && rj != 0 {
+			return ri < rj
+		}
+		if (ri == 0) != (rj == 0) {
+			return ri != 0
+		}
+		return sorted[i].Symbol < sorted[j].Symbol
+	})
+	return sorted
 }
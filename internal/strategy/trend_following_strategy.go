@@ -0,0 +1,231 @@
+package strategy
+
+import (
+	"fmt"
+	"strings"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+	pb "momentum-trading-platform/api/proto/strategy_service"
+	"momentum-trading-platform/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// TrendFollowingStrategy trades a small cross-asset ETF set on moving-average crossovers or
+// Donchian channel breakouts. Every asset trades on its own trend, independent of the equity
+// market regime, and positions are sized inversely to realized volatility by default.
+type TrendFollowingStrategy struct {
+	universe     []string
+	mode         string // "crossover" or "donchian"
+	fastPeriod   int
+	slowPeriod   int
+	entryPeriod  int          // Donchian breakout period
+	exitPeriod   int          // Donchian exit period
+	risk         RiskConfig   // Period is the volatilityPeriod parameter
+	regimeConfig RegimeConfig // Only the model is used, for reporting
+	regimeModel  RegimeModel
+}
+
+const (
+	trendModeCrossover = "crossover"
+	trendModeDonchian  = "donchian"
+)
+
+// defaultTrendUniverse covers US and international equities, government bonds, gold, broad commodities and real estate.
+var defaultTrendUniverse = []string{"SPY", "EFA", "EEM", "IEF", "TLT", "GLD", "DBC", "VNQ"}
+
+func NewTrendFollowingStrategy() *TrendFollowingStrategy {
+	s := &TrendFollowingStrategy{
+		universe:     defaultTrendUniverse,
+		mode:         trendModeCrossover,
+		fastPeriod:   50,
+		slowPeriod:   200,
+		entryPeriod:  55,
+		exitPeriod:   20,
+		risk:         defaultTrendRiskConfig(),
+		regimeConfig: DefaultRegimeConfig(),
+	}
+	s.regimeModel, _ = s.regimeConfig.NewRegimeModel()
+	return s
+}
+
+// DefaultUniverse returns the ETF set traded when a request does not name any symbols.
+func (s *TrendFollowingStrategy) DefaultUniverse() []string {
+	return s.universe
+}
+
+// GenerateSignals emits BUY for assets in an uptrend, SELL for assets in a downtrend and, in
+// Donchian mode, HOLD for assets between the exit and entry channels.
+func (s *TrendFollowingStrategy) GenerateSignals(batchStockData map[string]*datapb.StockResponse, indexData *datapb.StockResponse) (*pb.SignalResponse, error) {
+	assessment := s.DetectMarketRegime(indexData, batchStockData)
+	regime := assessment.Regime
+
+	var signals []*pb.StockSignal
+	diagnostics := make(map[string]*pb.SignalDiagnostic, len(batchStockData))
+	minHistory := MinHistoryFilter{MinDataPoints: s.requiredHistory()}
+
+	for symbol, data := range batchStockData {
+		diagnostic := &pb.SignalDiagnostic{Symbol: symbol, MarketRegime: pb.MarketRegime(regime)}
+		diagnostics[symbol] = diagnostic
+
+		filterResults, passed := FilterPipeline{minHistory}.Evaluate(data)
+		diagnostic.Filters = filterResults
+		if !passed {
+			continue
+		}
+
+		signal := s.generateSignal(symbol, data, diagnostic)
+		if signal.Signal == pb.SignalType_BUY {
+			diagnostic.Selected = true
+		}
+		signals = append(signals, signal)
+	}
+
+	rankByMomentum(signals)
+	s.risk.allocate(signals, batchStockData)
+	for i, signal := range signals {
+		diagnostics[signal.Symbol].Rank = int32(i + 1)
+	}
+	log.Infof("🔍 Evaluated %d assets for trend signals", len(signals))
+
+	return setRegime(&pb.SignalResponse{
+		Signals:     signals,
+		Diagnostics: sortDiagnostics(diagnostics),
+	}, assessment, RegimeFull, 1), nil
+}
+
+func (s *TrendFollowingStrategy) generateSignal(symbol string, stockResp *datapb.StockResponse, diagnostic *pb.SignalDiagnostic) *pb.StockSignal {
+	dataPoints := stockResp.DataPoints
+	lastPrice := dataPoints[len(dataPoints)-1].Close
+
+	signal := &pb.StockSignal{
+		Symbol:       symbol,
+		Signal:       pb.SignalType_HOLD,
+		CurrentPrice: lastPrice,
+	}
+	s.risk.applyRisk(signal, dataPoints)
+	diagnostic.Atr = utils.CalculateATR(dataPoints, 20)
+
+	switch s.mode {
+	case trendModeDonchian:
+		lower, _ := utils.CalculateDonchianChannel(dataPoints, s.exitPeriod)
+		entryLower, upper := utils.CalculateDonchianChannel(dataPoints, s.entryPeriod)
+		if upper > entryLower {
+			signal.MomentumScore = (lastPrice-entryLower)/(upper-entryLower)*2 - 1
+		}
+		switch {
+		case lastPrice > upper:
+			signal.Signal = pb.SignalType_BUY
+		case lastPrice < lower:
+			signal.Signal = pb.SignalType_SELL
+		}
+	default:
+		fast := utils.CalculateMovingAverage(dataPoints, s.fastPeriod)
+		slow := utils.CalculateMovingAverage(dataPoints, s.slowPeriod)
+		signal.MomentumScore = fast/slow - 1
+		diagnostic.MaDistance = (lastPrice - slow) / slow
+		if fast > slow {
+			signal.Signal = pb.SignalType_BUY
+		} else {
+			signal.Signal = pb.SignalType_SELL
+		}
+	}
+
+	diagnostic.MomentumScore = signal.MomentumScore
+	return signal
+}
+
+func (s *TrendFollowingStrategy) requiredHistory() int {
+	if s.mode == trendModeDonchian {
+		return max(s.entryPeriod, s.exitPeriod, s.risk.Period) + 1
+	}
+	return max(s.slowPeriod, s.risk.Period) + 1
+}
+
+// defaultTrendRiskConfig scales positions so that each contributes roughly targetVolatility of risk.
+func defaultTrendRiskConfig() RiskConfig {
+	risk := DefaultRiskConfig()
+	risk.Method = riskMethodInverseVolatility
+	risk.Period = 60
+	return risk
+}
+
+func (s *TrendFollowingStrategy) CalculateRisk(stockData *datapb.StockResponse) float64 {
+	riskUnit, _ := s.risk.riskUnit(stockData.DataPoints)
+	return riskUnit
+}
+
+func (s *TrendFollowingStrategy) DetectMarketRegime(indexData *datapb.StockResponse, universe map[string]*datapb.StockResponse) RegimeAssessment {
+	return s.regimeModel.Assess(indexData, universe)
+}
+
+func (s *TrendFollowingStrategy) ParameterSchema() []ParameterSpec {
+	return append([]ParameterSpec{
+		{Name: "universe", Type: ListParameter, Default: strings.Join(defaultTrendUniverse, ","), Description: "ETFs traded when a request names no symbols"},
+		{Name: "mode", Type: StringParameter, Default: trendModeCrossover, Description: "crossover or donchian"},
+		{Name: "fastPeriod", Type: IntParameter, Default: 50, Min: 5, Max: 100, Description: "Fast moving average period"},
+		{Name: "slowPeriod", Type: IntParameter, Default: 200, Min: 50, Max: 300, Description: "Slow moving average period"},
+		{Name: "entryPeriod", Type: IntParameter, Default: 55, Min: 10, Max: 252, Description: "Donchian breakout period"},
+		{Name: "exitPeriod", Type: IntParameter, Default: 20, Min: 5, Max: 126, Description: "Donchian exit period"},
+	}, append(riskParameterSchema(defaultTrendRiskConfig(), "volatilityPeriod"), regimeModelParameterSchema(DefaultRegimeConfig())...)...)
+}
+
+func (s *TrendFollowingStrategy) GetParameters() map[string]interface{} {
+	return regimeModelParameters(s.regimeConfig, riskParameters(s.risk, "volatilityPeriod", map[string]interface{}{
+		"universe":    strings.Join(s.universe, ","),
+		"mode":        s.mode,
+		"fastPeriod":  s.fastPeriod,
+		"slowPeriod":  s.slowPeriod,
+		"entryPeriod": s.entryPeriod,
+		"exitPeriod":  s.exitPeriod,
+	}))
+}
+
+func (s *TrendFollowingStrategy) SetParameters(params map[string]interface{}) error {
+	next := *s
+
+	r := newParamReader(params)
+	r.List("universe", &next.universe)
+	r.String("mode", &next.mode)
+	r.Int("fastPeriod", &next.fastPeriod)
+	r.Int("slowPeriod", &next.slowPeriod)
+	r.Int("entryPeriod", &next.entryPeriod)
+	r.Int("exitPeriod", &next.exitPeriod)
+	readRiskParameters(r, &next.risk, "volatilityPeriod")
+	readRegimeModelParameters(r, &next.regimeConfig)
+	if err := r.Err(); err != nil {
+		return err
+	}
+
+	if next.mode != trendModeCrossover && next.mode != trendModeDonchian {
+		return fmt.Errorf("mode must be %q or %q, got %q", trendModeCrossover, trendModeDonchian, next.mode)
+	}
+	if next.fastPeriod <= 0 || next.fastPeriod >= next.slowPeriod {
+		return fmt.Errorf("fastPeriod (%d) must be positive and below slowPeriod (%d)", next.fastPeriod, next.slowPeriod)
+	}
+	if next.exitPeriod <= 0 || next.exitPeriod > next.entryPeriod {
+		return fmt.Errorf("exitPeriod (%d) must be positive and not above entryPeriod (%d)", next.exitPeriod, next.entryPeriod)
+	}
+	if err := next.risk.validate(); err != nil {
+		return err
+	}
+	if err := next.regimeConfig.validate(); err != nil {
+		return err
+	}
+	regimeModel, err := next.regimeConfig.NewRegimeModel()
+	if err != nil {
+		return err
+	}
+	next.regimeModel = regimeModel
+	if len(next.universe) == 0 {
+		return fmt.Errorf("universe must not be empty")
+	}
+	universe := make([]string, len(next.universe))
+	for i, symbol := range next.universe {
+		universe[i] = strings.ToUpper(symbol)
+	}
+	next.universe = universe
+
+	*s = next
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 
 	portfoliostatepb "momentum-trading-platform/api/proto/portfolio_state_service"
 	pb "momentum-trading-platform/api/proto/trade_execution_service"
+	"momentum-trading-platform/internal/utils"
 )
 
 type Server struct {
@@ -39,11 +40,19 @@ func NewServer(clients *Clients) *Server {
 }
 
 func (s *Server) ExecuteTrades(ctx context.Context, req *pb.ExecuteTradesRequest) (*pb.ExecuteTradesResponse, error) {
+	portfolioID, err := utils.PortfolioID(req.PortfolioId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	executionID := uuid.New().String()
-	s.Logger.WithField("executionID", executionID).Info("Executing trades")
+	s.Logger.WithFields(log.Fields{
+		"executionID": executionID,
+		"portfolioId": portfolioID,
+	}).Info("Executing trades")
 
 	results := make([]*pb.OrderExecutionResult, len(req.Orders))
 	for i, order := range req.Orders {
@@ -56,11 +65,12 @@ func (s *Server) ExecuteTrades(ctx context.Context, req *pb.ExecuteTradesRequest
 		ExecutionId: executionID,
 		Status:      pb.ExecutionStatusType_COMPLETED,
 		Results:     results,
+		PortfolioId: portfolioID,
 	}
 	s.executions[executionID] = executionStatus
 
-	// Update portfolio state
-	err := s.updatePortfolioState(ctx, results)
+	// Update the state of the portfolio the trades were made for
+	err = s.updatePortfolioState(ctx, portfolioID, results)
 	if err != nil {
 		s.Logger.WithError(err).Error("Failed to update portfolio state")
 		return nil, status.Errorf(codes.Internal, "failed to update portfolio state: %v", err)
@@ -84,9 +94,11 @@ func (s *Server) GetExecutionStatus(ctx context.Context, req *pb.GetExecutionSta
 	return executionStatus, nil
 }
 
-func (s *Server) updatePortfolioState(ctx context.Context, results []*pb.OrderExecutionResult) error {
+func (s *Server) updatePortfolioState(ctx context.Context, portfolioID string, results []*pb.OrderExecutionResult) error {
 	// First, get the current portfolio state
-	currentState, err := s.Clients.PortfolioStateClient.GetPortfolioState(ctx, &portfoliostatepb.GetPortfolioStateRequest{})
+	currentState, err := s.Clients.PortfolioStateClient.GetPortfolioState(ctx, &portfoliostatepb.GetPortfolioStateRequest{
+		PortfolioId: portfolioID,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to get current portfolio state: %w", err)
 	}
@@ -129,6 +141,7 @@ func (s *Server) updatePortfolioState(ctx context.Context, results []*pb.OrderEx
 	_, err = s.Clients.PortfolioStateClient.UpdatePortfolioState(ctx, &portfoliostatepb.UpdatePortfolioStateRequest{
 		Positions:   positions,
 		CashBalance: cashBalance,
+		PortfolioId: portfolioID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update portfolio state: %w", err)
@@ -158,13 +171,15 @@ func (s *Server) simulateExecution(order *pb.Order) *pb.OrderExecutionResult {
 	}
 
 	// Simulate partial fills
+	orderStatus := pb.ExecutionStatusType_COMPLETED
 	if rand.Float32() < 0.1 { // 10% chance of partial fill
 		filledQuantity = int32(float32(order.Quantity) * rand.Float32())
+		orderStatus = pb.ExecutionStatusType_PARTIAL
 	}
 
 	return &pb.OrderExecutionResult{
 		Symbol:         order.Symbol,
-		Status:         pb.ExecutionStatusType_COMPLETED,
+		Status:         orderStatus,
 		FilledQuantity: filledQuantity,
 		AveragePrice:   averagePrice,
 	}
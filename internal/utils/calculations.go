@@ -6,8 +6,6 @@ import (
 
 	datapb "momentum-trading-platform/api/proto/data_service"
 	stratpb "momentum-trading-platform/api/proto/strategy_service"
-
-	"gonum.org/v1/gonum/stat"
 )
 
 func IsWednesday(date string) bool {
@@ -21,25 +19,43 @@ func IsSecondWednesdayOfMonth(date string) bool {
 }
 
 func CalculateMomentumScore(dataPoints []*datapb.StockDataPoint, period int) float64 {
-	if len(dataPoints) < period {
-		return 0
-	}
+	annualizedSlope, r2 := CalculateMomentumRegression(dataPoints, period)
+	return annualizedSlope * r2
+}
 
-	x := make([]float64, period)
-	y := make([]float64, period)
+// CalculateMomentumRegression fits a linear regression to the log prices of the last period data points
+// and returns the annualized slope together with the R² of the fit. The fit is computed from running
+// sums so that scoring large universes does not allocate per symbol.
+func CalculateMomentumRegression(dataPoints []*datapb.StockDataPoint, period int) (float64, float64) {
+	if period < 2 || len(dataPoints) < period {
+		return 0, 0
+	}
 
+	// Log prices are taken relative to the first one, which keeps the sums well conditioned
 	data := dataPoints[len(dataPoints)-period:]
-
+	origin := math.Log(data[0].AdjustedClose)
+	var sumY, sumYY, sumXY float64
 	for i, dp := range data {
-		x[i] = float64(i) + 1.0
-		y[i] = math.Log(dp.AdjustedClose)
+		y := math.Log(dp.AdjustedClose) - origin
+		sumY += y
+		sumYY += y * y
+		sumXY += float64(i+1) * y
 	}
 
-	alpha, beta := stat.LinearRegression(x, y, nil, false)
-	r2 := stat.RSquared(x, y, nil, alpha, beta)
+	n := float64(period)
+	meanX := (n + 1) / 2
+	sxx := n * (n*n - 1) / 12
+	sxy := sumXY - meanX*sumY
+	syy := sumYY - sumY*sumY/n
+
+	beta := sxy / sxx
+	r2 := 0.0
+	if syy > 0 {
+		r2 = sxy * sxy / (sxx * syy)
+	}
 
 	annualizedSlope := math.Exp(beta*252) - 1 // Assuming 252 trading days in a year
-	return annualizedSlope * r2
+	return annualizedSlope, r2
 }
 
 // GenerateSignal generates a trading signal based on momentum score, price, and moving average
@@ -55,21 +71,30 @@ func CalculateRiskUnit(atr, riskFactor float64) float64 {
 	return riskFactor / atr
 }
 
-// CalculateATR calculates the Average True Range (ATR)
+// TargetExposure returns the fraction of the portfolio value to invest in the signals of a response.
+// A response without a target exposure is fully invested; a cash regime action reports zero.
+func TargetExposure(resp *stratpb.SignalResponse) float64 {
+	if resp.TargetExposure == nil {
+		return 1
+	}
+	return math.Min(math.Max(*resp.TargetExposure, 0), 1)
+}
+
+// CalculateATR calculates the Average True Range (ATR) over the last period true ranges
 func CalculateATR(dataPoints []*datapb.StockDataPoint, period int) float64 {
-	if len(dataPoints) < period {
+	if period <= 0 || len(dataPoints) <= period {
 		return 0
 	}
 
-	trueRanges := make([]float64, len(dataPoints)-1)
-	for i := 1; i < len(dataPoints); i++ {
+	sum := 0.0
+	for i := len(dataPoints) - period; i < len(dataPoints); i++ {
 		high := dataPoints[i].High
 		low := dataPoints[i].Low
 		prevClose := dataPoints[i-1].Close
-		trueRanges[i-1] = math.Max(high-low, math.Max(math.Abs(high-prevClose), math.Abs(low-prevClose)))
+		sum += math.Max(high-low, math.Max(math.Abs(high-prevClose), math.Abs(low-prevClose)))
 	}
 
-	return stat.Mean(trueRanges[len(trueRanges)-period:], nil)
+	return sum / float64(period)
 }
 
 // CalculateMovingAverage calculates the moving average for a given period
@@ -97,3 +122,38 @@ func HasRecentLargeGap(dataPoints []*datapb.StockDataPoint, period int, max_gap
 	}
 	return false
 }
+
+// CalculateRSI calculates the Relative Strength Index using Wilder's smoothing over all available data points
+func CalculateRSI(dataPoints []*datapb.StockDataPoint, period int) float64 {
+	if period <= 0 || len(dataPoints) <= period {
+		return 0
+	}
+	return Last(RSI(Closes(dataPoints), period))
+}
+
+// CalculateBollingerBands calculates the lower, middle and upper Bollinger Bands over the last period closes
+func CalculateBollingerBands(dataPoints []*datapb.StockDataPoint, period int, numStdDev float64) (float64, float64, float64) {
+	if period <= 0 || len(dataPoints) < period {
+		return 0, 0, 0
+	}
+	middle, upper, lower := BollingerBands(Closes(dataPoints), period, numStdDev)
+	return Last(lower), Last(middle), Last(upper)
+}
+
+// CalculateDonchianChannel returns the lowest low and highest high over the period preceding the last data point
+func CalculateDonchianChannel(dataPoints []*datapb.StockDataPoint, period int) (float64, float64) {
+	if period <= 0 || len(dataPoints) <= period {
+		return 0, 0
+	}
+	upper, lower := DonchianChannels(Highs(dataPoints), Lows(dataPoints), period)
+	previous := len(dataPoints) - 2
+	return lower[previous], upper[previous]
+}
+
+// CalculateVolatility calculates the annualized standard deviation of daily log returns over the last period data points
+func CalculateVolatility(dataPoints []*datapb.StockDataPoint, period int) float64 {
+	if period < 2 || len(dataPoints) <= period {
+		return 0
+	}
+	return Last(RollingVolatility(AdjustedCloses(dataPoints), period))
+}
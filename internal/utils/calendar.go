@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"time"
+	_ "time/tzdata" // The exchange time zone must resolve in minimal containers without zoneinfo
+)
+
+// MarketLocation is the time zone of the NYSE, in which trading days are defined.
+var MarketLocation = mustLoadLocation("America/New_York")
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// IsTradingDay reports whether the NYSE is open on the calendar date of t in the exchange time
+// zone. Weekends and the regular full-day holidays are closed; unscheduled closures are not known.
+func IsTradingDay(t time.Time) bool {
+	t = t.In(MarketLocation)
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return false
+	}
+	year, month, day := t.Date()
+	for _, holiday := range NYSEHolidays(year) {
+		if holiday.Month() == month && holiday.Day() == day {
+			return false
+		}
+	}
+	return true
+}
+
+// MarketDayStart returns midnight of the date of t in the exchange time zone.
+func MarketDayStart(t time.Time) time.Time {
+	t = t.In(MarketLocation)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, MarketLocation)
+}
+
+// NextTradingDay returns the first trading day on or after the date of t, at midnight in the
+// exchange time zone.
+func NextTradingDay(t time.Time) time.Time {
+	day := MarketDayStart(t)
+	for !IsTradingDay(day) {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+// NYSEHolidays returns the dates the NYSE is closed for holidays in the given year. Holidays on a
+// Saturday are observed the Friday before and holidays on a Sunday the Monday after, except New
+// Year's Day, which is not observed when it falls on a Saturday.
+func NYSEHolidays(year int) []time.Time {
+	holidays := []time.Time{
+		nthWeekday(year, time.January, time.Monday, 3),    // Martin Luther King Jr. Day
+		nthWeekday(year, time.February, time.Monday, 3),   // Washington's Birthday
+		easterSunday(year).AddDate(0, 0, -2),              // Good Friday
+		lastWeekday(year, time.May, time.Monday),          // Memorial Day
+		observed(date(year, time.July, 4)),                // Independence Day
+		nthWeekday(year, time.September, time.Monday, 1),  // Labor Day
+		nthWeekday(year, time.November, time.Thursday, 4), // Thanksgiving Day
+		observed(date(year, time.December, 25)),           // Christmas Day
+	}
+	if newYear := date(year, time.January, 1); newYear.Weekday() != time.Saturday {
+		holidays = append(holidays, observed(newYear))
+	}
+	if year >= 2022 {
+		holidays = append(holidays, observed(date(year, time.June, 19))) // Juneteenth
+	}
+	return holidays
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, MarketLocation)
+}
+
+func observed(holiday time.Time) time.Time {
+	switch holiday.Weekday() {
+	case time.Saturday:
+		return holiday.AddDate(0, 0, -1)
+	case time.Sunday:
+		return holiday.AddDate(0, 0, 1)
+	}
+	return holiday
+}
+
+// nthWeekday returns the nth occurrence of weekday in the month, counting from one.
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	first := date(year, month, 1)
+	offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, offset+7*(n-1))
+}
+
+// lastWeekday returns the last occurrence of weekday in the month.
+func lastWeekday(year int, month time.Month, weekday time.Weekday) time.Time {
+	last := date(year, month+1, 0)
+	offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+	return last.AddDate(0, 0, -offset)
+}
+
+// easterSunday returns the date of Easter in the Gregorian calendar (anonymous Gregorian algorithm).
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return date(year, time.Month(month), day)
+}
@@ -0,0 +1,340 @@
+package utils
+
+import (
+	"math"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+
+	"gonum.org/v1/gonum/stat"
+)
+
+// The indicators in this file operate on full price series and return series of the same length,
+// aligned index by index with the input. Entries before an indicator has enough data (the warm-up
+// period) are NaN.
+
+// Closes extracts the close prices from data points.
+func Closes(dataPoints []*datapb.StockDataPoint) []float64 {
+	values := make([]float64, len(dataPoints))
+	for i, dp := range dataPoints {
+		values[i] = dp.Close
+	}
+	return values
+}
+
+// AdjustedCloses extracts the adjusted close prices from data points.
+func AdjustedCloses(dataPoints []*datapb.StockDataPoint) []float64 {
+	values := make([]float64, len(dataPoints))
+	for i, dp := range dataPoints {
+		values[i] = dp.AdjustedClose
+	}
+	return values
+}
+
+// Highs extracts the high prices from data points.
+func Highs(dataPoints []*datapb.StockDataPoint) []float64 {
+	values := make([]float64, len(dataPoints))
+	for i, dp := range dataPoints {
+		values[i] = dp.High
+	}
+	return values
+}
+
+// Lows extracts the low prices from data points.
+func Lows(dataPoints []*datapb.StockDataPoint) []float64 {
+	values := make([]float64, len(dataPoints))
+	for i, dp := range dataPoints {
+		values[i] = dp.Low
+	}
+	return values
+}
+
+// Volumes extracts the traded volumes from data points.
+func Volumes(dataPoints []*datapb.StockDataPoint) []float64 {
+	values := make([]float64, len(dataPoints))
+	for i, dp := range dataPoints {
+		values[i] = float64(dp.Volume)
+	}
+	return values
+}
+
+// Last returns the last value of a series, or NaN for an empty series.
+func Last(series []float64) float64 {
+	if len(series) == 0 {
+		return math.NaN()
+	}
+	return series[len(series)-1]
+}
+
+func nanSeries(n int) []float64 {
+	series := make([]float64, n)
+	for i := range series {
+		series[i] = math.NaN()
+	}
+	return series
+}
+
+// SMA calculates the simple moving average. The first value is at index period-1.
+func SMA(values []float64, period int) []float64 {
+	result := nanSeries(len(values))
+	if period <= 0 || len(values) < period {
+		return result
+	}
+
+	sum := 0.0
+	for i, v := range values {
+		sum += v
+		if i >= period {
+			sum -= values[i-period]
+		}
+		if i >= period-1 {
+			result[i] = sum / float64(period)
+		}
+	}
+	return result
+}
+
+// EMA calculates the exponential moving average with smoothing 2/(period+1), seeded with the
+// simple average of the first period values. The first value is at index period-1.
+func EMA(values []float64, period int) []float64 {
+	return emaFrom(values, period, 0)
+}
+
+// emaFrom calculates an EMA over values[start:], leaving everything before the seed as NaN.
+func emaFrom(values []float64, period, start int) []float64 {
+	result := nanSeries(len(values))
+	if period <= 0 || len(values)-start < period {
+		return result
+	}
+
+	alpha := 2 / float64(period+1)
+	seed := 0.0
+	for _, v := range values[start : start+period] {
+		seed += v
+	}
+	result[start+period-1] = seed / float64(period)
+	for i := start + period; i < len(values); i++ {
+		result[i] = alpha*values[i] + (1-alpha)*result[i-1]
+	}
+	return result
+}
+
+// TrueRange calculates the true range of each bar. The first bar has no previous close, so its
+// true range is NaN.
+func TrueRange(high, low, close []float64) []float64 {
+	result := nanSeries(len(close))
+	for i := 1; i < len(close); i++ {
+		result[i] = math.Max(high[i]-low[i], math.Max(math.Abs(high[i]-close[i-1]), math.Abs(low[i]-close[i-1])))
+	}
+	return result
+}
+
+// WilderATR calculates the Average True Range with Wilder's smoothing. The first value, at index
+// period, is the simple average of the first period true ranges.
+func WilderATR(high, low, close []float64, period int) []float64 {
+	return wilderSmooth(TrueRange(high, low, close), period)
+}
+
+// wilderSmooth applies Wilder's smoothing to a series whose first value (index 0) is undefined.
+// The result starts at index period with the simple average of values[1:period+1].
+func wilderSmooth(values []float64, period int) []float64 {
+	result := nanSeries(len(values))
+	if period <= 0 || len(values) <= period {
+		return result
+	}
+
+	sum := 0.0
+	for _, v := range values[1 : period+1] {
+		sum += v
+	}
+	result[period] = sum / float64(period)
+	for i := period + 1; i < len(values); i++ {
+		result[i] = (result[i-1]*float64(period-1) + values[i]) / float64(period)
+	}
+	return result
+}
+
+// RSI calculates the Relative Strength Index with Wilder's smoothing. The first value is at index period.
+func RSI(values []float64, period int) []float64 {
+	gains := nanSeries(len(values))
+	losses := nanSeries(len(values))
+	for i := 1; i < len(values); i++ {
+		change := values[i] - values[i-1]
+		gains[i] = math.Max(change, 0)
+		losses[i] = math.Max(-change, 0)
+	}
+
+	avgGains := wilderSmooth(gains, period)
+	avgLosses := wilderSmooth(losses, period)
+	result := nanSeries(len(values))
+	for i := range values {
+		if math.IsNaN(avgGains[i]) {
+			continue
+		}
+		if avgLosses[i] == 0 {
+			result[i] = 100
+			continue
+		}
+		result[i] = 100 - 100/(1+avgGains[i]/avgLosses[i])
+	}
+	return result
+}
+
+// MACD calculates the MACD line (fast EMA minus slow EMA), its signal line (EMA of the MACD line)
+// and the histogram (MACD minus signal). The MACD line starts at index slow-1 and the signal line
+// and histogram at index slow+signal-2. The fast period must be shorter than the slow one;
+// otherwise every series is NaN.
+func MACD(values []float64, fast, slow, signal int) ([]float64, []float64, []float64) {
+	macd := nanSeries(len(values))
+	signalLine := nanSeries(len(values))
+	histogram := nanSeries(len(values))
+	if fast <= 0 || slow <= fast || signal <= 0 {
+		return macd, signalLine, histogram
+	}
+
+	fastEMA := EMA(values, fast)
+	slowEMA := EMA(values, slow)
+	for i := range values {
+		macd[i] = fastEMA[i] - slowEMA[i]
+	}
+
+	signalLine = emaFrom(macd, signal, slow-1)
+	for i := range values {
+		histogram[i] = macd[i] - signalLine[i]
+	}
+	return macd, signalLine, histogram
+}
+
+// BollingerBands calculates the middle band (simple moving average) and the upper and lower bands
+// numStdDev population standard deviations away. The first value is at index period-1.
+func BollingerBands(values []float64, period int, numStdDev float64) ([]float64, []float64, []float64) {
+	middle := SMA(values, period)
+	upper := nanSeries(len(values))
+	lower := nanSeries(len(values))
+	for i := period - 1; i >= 0 && i < len(values); i++ {
+		_, stdDev := stat.PopMeanStdDev(values[i-period+1:i+1], nil)
+		upper[i] = middle[i] + numStdDev*stdDev
+		lower[i] = middle[i] - numStdDev*stdDev
+	}
+	return middle, upper, lower
+}
+
+// ADX calculates the Average Directional Index together with the +DI and -DI lines using Wilder's
+// smoothing. The DI lines start at index period and the ADX at index 2*period-1.
+func ADX(high, low, close []float64, period int) ([]float64, []float64, []float64) {
+	n := len(close)
+	plusDM := nanSeries(n)
+	minusDM := nanSeries(n)
+	for i := 1; i < n; i++ {
+		up := high[i] - high[i-1]
+		down := low[i-1] - low[i]
+		plusDM[i], minusDM[i] = 0, 0
+		if up > down && up > 0 {
+			plusDM[i] = up
+		}
+		if down > up && down > 0 {
+			minusDM[i] = down
+		}
+	}
+
+	atr := wilderSmooth(TrueRange(high, low, close), period)
+	smoothedPlus := wilderSmooth(plusDM, period)
+	smoothedMinus := wilderSmooth(minusDM, period)
+
+	plusDI := nanSeries(n)
+	minusDI := nanSeries(n)
+	dx := nanSeries(n)
+	for i := 0; i < n; i++ {
+		if math.IsNaN(atr[i]) || atr[i] == 0 {
+			continue
+		}
+		plusDI[i] = 100 * smoothedPlus[i] / atr[i]
+		minusDI[i] = 100 * smoothedMinus[i] / atr[i]
+		if sum := plusDI[i] + minusDI[i]; sum > 0 {
+			dx[i] = 100 * math.Abs(plusDI[i]-minusDI[i]) / sum
+		} else {
+			dx[i] = 0
+		}
+	}
+
+	adx := nanSeries(n)
+	if period > 0 && n >= 2*period {
+		// dx starts at index period, so shift it to reuse Wilder's smoothing
+		adx = shiftedWilderSmooth(dx, period, period-1)
+	}
+	return adx, plusDI, minusDI
+}
+
+// shiftedWilderSmooth applies wilderSmooth to values[offset:] and realigns the result.
+func shiftedWilderSmooth(values []float64, period, offset int) []float64 {
+	smoothed := wilderSmooth(values[offset:], period)
+	result := nanSeries(len(values))
+	copy(result[offset:], smoothed)
+	return result
+}
+
+// LogReturns calculates log returns. The first value is NaN.
+func LogReturns(values []float64) []float64 {
+	result := nanSeries(len(values))
+	for i := 1; i < len(values); i++ {
+		result[i] = math.Log(values[i] / values[i-1])
+	}
+	return result
+}
+
+// RollingVolatility calculates the annualized (252 trading days) sample standard deviation of
+// log returns over a rolling window of period returns. The first value is at index period.
+func RollingVolatility(values []float64, period int) []float64 {
+	returns := LogReturns(values)
+	result := nanSeries(len(values))
+	if period < 2 {
+		return result
+	}
+	for i := period; i < len(values); i++ {
+		result[i] = stat.StdDev(returns[i-period+1:i+1], nil) * math.Sqrt(252)
+	}
+	return result
+}
+
+// RollingBeta calculates the beta of asset to benchmark log returns over a rolling window of
+// period returns. Both series must be aligned. The first value is at index period.
+func RollingBeta(asset, benchmark []float64, period int) []float64 {
+	n := min(len(asset), len(benchmark))
+	assetReturns := LogReturns(asset[:n])
+	benchmarkReturns := LogReturns(benchmark[:n])
+	result := nanSeries(n)
+	if period < 2 {
+		return result
+	}
+	for i := period; i < n; i++ {
+		a := assetReturns[i-period+1 : i+1]
+		b := benchmarkReturns[i-period+1 : i+1]
+		if variance := stat.Variance(b, nil); variance > 0 {
+			result[i] = stat.Covariance(a, b, nil) / variance
+		}
+	}
+	return result
+}
+
+// DonchianChannels calculates the highest high and lowest low over the last period bars,
+// including the current bar. The first value is at index period-1.
+func DonchianChannels(high, low []float64, period int) ([]float64, []float64) {
+	upper := nanSeries(len(high))
+	lower := nanSeries(len(low))
+	for i := period - 1; i >= 0 && i < len(high); i++ {
+		upper[i], lower[i] = math.Inf(-1), math.Inf(1)
+		for j := i - period + 1; j <= i; j++ {
+			upper[i] = math.Max(upper[i], high[j])
+			lower[i] = math.Min(lower[i], low[j])
+		}
+	}
+	return upper, lower
+}
+
+// ROC calculates the rate of change over period bars as a fraction. The first value is at index period.
+func ROC(values []float64, period int) []float64 {
+	result := nanSeries(len(values))
+	for i := period; period > 0 && i < len(values); i++ {
+		result[i] = values[i]/values[i-period] - 1
+	}
+	return result
+}
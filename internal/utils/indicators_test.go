@@ -0,0 +1,186 @@
+package utils
+
+import (
+	"math"
+	"testing"
+)
+
+var nan = math.NaN()
+
+// rsiCloses are the closes of the 14-day RSI spreadsheet published by StockCharts.
+var rsiCloses = []float64{
+	44.34, 44.09, 44.15, 43.61, 44.33, 44.83, 45.10, 45.42, 45.84, 46.08, 45.89, 46.03, 45.61, 46.28,
+	46.28, 46.00, 46.03, 46.41, 46.22, 45.64, 46.21, 46.25, 45.71, 46.45, 45.78, 45.35, 44.03, 44.18,
+	44.22, 44.57, 43.42, 42.66, 43.13,
+}
+
+// Prices of a short series used for the range based indicators.
+var (
+	testHighs  = []float64{10, 12, 13, 11}
+	testLows   = []float64{9, 10, 11, 8}
+	testCloses = []float64{9.5, 11, 12, 9}
+)
+
+func assertSeries(t *testing.T, name string, got, want []float64, tolerance float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: got %d values, want %d", name, len(got), len(want))
+	}
+	for i := range want {
+		if math.IsNaN(want[i]) {
+			if !math.IsNaN(got[i]) {
+				t.Errorf("%s[%d] = %v, want NaN", name, i, got[i])
+			}
+			continue
+		}
+		if math.Abs(got[i]-want[i]) > tolerance {
+			t.Errorf("%s[%d] = %v, want %v", name, i, got[i], want[i])
+		}
+	}
+}
+
+func TestMovingAverages(t *testing.T) {
+	tests := []struct {
+		name string
+		got  []float64
+		want []float64
+	}{
+		{"SMA", SMA([]float64{1, 2, 3, 4, 5}, 3), []float64{nan, nan, 2, 3, 4}},
+		{"SMA too short", SMA([]float64{1, 2}, 3), []float64{nan, nan}},
+		{"EMA", EMA([]float64{2, 4, 6, 8, 12}, 3), []float64{nan, nan, 4, 6, 9}},
+		{"EMA invalid period", EMA([]float64{2, 4, 6}, 0), []float64{nan, nan, nan}},
+	}
+	for _, tt := range tests {
+		assertSeries(t, tt.name, tt.got, tt.want, 1e-9)
+	}
+}
+
+func TestRSI(t *testing.T) {
+	want := []float64{
+		nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan,
+		70.46, 66.25, 66.48, 69.35, 66.29, 57.92, 62.88, 63.21, 56.01, 62.34, 54.67, 50.39, 40.02, 41.49,
+		41.90, 45.50, 37.32, 33.09, 37.79,
+	}
+	assertSeries(t, "RSI", RSI(rsiCloses, 14), want, 0.01)
+	assertSeries(t, "RSI without losses", RSI([]float64{1, 2, 3}, 2), []float64{nan, nan, 100}, 1e-9)
+}
+
+func TestMACD(t *testing.T) {
+	values := []float64{2, 4, 6, 8, 12, 10}
+	tests := []struct {
+		name                   string
+		fast, slow, signal     int
+		macd, signalLine, hist []float64
+	}{
+		{
+			name: "fast shorter than slow",
+			fast: 2, slow: 3, signal: 2,
+			macd:       []float64{nan, nan, 1, 1, 4.0 / 3, 11.0 / 18},
+			signalLine: []float64{nan, nan, nan, 1, 11.0 / 9, 22.0 / 27},
+			hist:       []float64{nan, nan, nan, 0, 1.0 / 9, -11.0 / 54},
+		},
+		{
+			name: "fast longer than slow",
+			fast: 3, slow: 2, signal: 2,
+			macd:       []float64{nan, nan, nan, nan, nan, nan},
+			signalLine: []float64{nan, nan, nan, nan, nan, nan},
+			hist:       []float64{nan, nan, nan, nan, nan, nan},
+		},
+		{
+			name: "equal periods",
+			fast: 3, slow: 3, signal: 2,
+			macd:       []float64{nan, nan, nan, nan, nan, nan},
+			signalLine: []float64{nan, nan, nan, nan, nan, nan},
+			hist:       []float64{nan, nan, nan, nan, nan, nan},
+		},
+	}
+	for _, tt := range tests {
+		macd, signalLine, hist := MACD(values, tt.fast, tt.slow, tt.signal)
+		assertSeries(t, tt.name+" macd", macd, tt.macd, 1e-9)
+		assertSeries(t, tt.name+" signal", signalLine, tt.signalLine, 1e-9)
+		assertSeries(t, tt.name+" histogram", hist, tt.hist, 1e-9)
+	}
+}
+
+func TestBollingerBands(t *testing.T) {
+	middle, upper, lower := BollingerBands([]float64{2, 4, 4, 4, 5, 5, 7, 9}, 8, 2)
+	assertSeries(t, "middle", middle, []float64{nan, nan, nan, nan, nan, nan, nan, 5}, 1e-9)
+	assertSeries(t, "upper", upper, []float64{nan, nan, nan, nan, nan, nan, nan, 9}, 1e-9)
+	assertSeries(t, "lower", lower, []float64{nan, nan, nan, nan, nan, nan, nan, 1}, 1e-9)
+}
+
+func TestRangeIndicators(t *testing.T) {
+	adx, plusDI, minusDI := ADX(testHighs, testLows, testCloses, 2)
+	upper, lower := DonchianChannels([]float64{1, 3, 2, 5, 4}, []float64{0, 2, 1, 3, 1}, 3)
+
+	tests := []struct {
+		name string
+		got  []float64
+		want []float64
+	}{
+		{"TrueRange", TrueRange(testHighs, testLows, testCloses), []float64{nan, 2.5, 2, 4}},
+		{"WilderATR", WilderATR(testHighs, testLows, testCloses, 2), []float64{nan, nan, 2.25, 3.125}},
+		{"ADX", adx, []float64{nan, nan, nan, 200.0 / 3}},
+		{"+DI", plusDI, []float64{nan, nan, 200.0 / 3, 24}},
+		{"-DI", minusDI, []float64{nan, nan, 0, 48}},
+		{"Donchian upper", upper, []float64{nan, nan, 3, 5, 5}},
+		{"Donchian lower", lower, []float64{nan, nan, 0, 1, 1}},
+	}
+	for _, tt := range tests {
+		assertSeries(t, tt.name, tt.got, tt.want, 1e-9)
+	}
+}
+
+func TestRollingVolatility(t *testing.T) {
+	// Log returns of 0.01, -0.01, 0.02 and 0
+	closes := []float64{100, 100 * math.Exp(0.01), 100, 100 * math.Exp(0.02), 100 * math.Exp(0.02)}
+	tests := []struct {
+		name string
+		got  []float64
+		want []float64
+	}{
+		{"period 2", RollingVolatility(closes, 2), []float64{nan, nan, 0.22449944320643467, 0.3367491648096538, 0.2244994432064361}},
+		{"period 3", RollingVolatility(closes, 3), []float64{nan, nan, nan, 0.24248711305964193, 0.24248711305964216}},
+		{"period too short", RollingVolatility(closes, 1), []float64{nan, nan, nan, nan, nan}},
+	}
+	for _, tt := range tests {
+		assertSeries(t, tt.name, tt.got, tt.want, 1e-9)
+	}
+}
+
+func TestRollingBeta(t *testing.T) {
+	// The asset has one bar more than the benchmark, so the results cover the benchmark's bars
+	asset := []float64{50, 51.5, 50.2, 53.9, 53.0, 60}
+	benchmark := []float64{100, 102, 101, 104, 103}
+	tests := []struct {
+		name string
+		got  []float64
+		want []float64
+	}{
+		{"period 2", RollingBeta(asset, benchmark, 2), []float64{nan, nan, 1.8589015334643564, 2.471257983740827, 2.259153220219926}},
+		{"period 3", RollingBeta(asset, benchmark, 3), []float64{nan, nan, nan, 2.324602063957802, 2.3662402819337656}},
+		{"shorter asset", RollingBeta(asset[:4], benchmark, 2), []float64{nan, nan, 1.8589015334643564, 2.471257983740827}},
+		{"flat benchmark", RollingBeta([]float64{100, 101, 102, 103}, []float64{100, 100, 100, 101}, 2), []float64{nan, nan, nan, -0.009660130810261404}},
+		{"period too short", RollingBeta(asset, benchmark, 1), []float64{nan, nan, nan, nan, nan}},
+	}
+	for _, tt := range tests {
+		assertSeries(t, tt.name, tt.got, tt.want, 1e-9)
+	}
+}
+
+func TestROC(t *testing.T) {
+	values := []float64{100, 110, 121, 99}
+	tests := []struct {
+		name string
+		got  []float64
+		want []float64
+	}{
+		{"period 1", ROC(values, 1), []float64{nan, 0.1, 0.1, -2.0 / 11}},
+		{"period 2", ROC(values, 2), []float64{nan, nan, 0.21, -0.1}},
+		{"period longer than series", ROC(values, 4), []float64{nan, nan, nan, nan}},
+		{"invalid period", ROC(values, 0), []float64{nan, nan, nan, nan}},
+	}
+	for _, tt := range tests {
+		assertSeries(t, tt.name, tt.got, tt.want, 1e-9)
+	}
+}
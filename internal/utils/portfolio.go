@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DefaultPortfolioID is the portfolio that requests naming none act on, so that deployments and
+// clients managing a single account keep working unchanged.
+const DefaultPortfolioID = "default"
+
+var portfolioIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// PortfolioID returns the portfolio a request names, or the default portfolio when it names none.
+// Ids are up to 64 letters, digits, dashes and underscores.
+func PortfolioID(id string) (string, error) {
+	if id == "" {
+		return DefaultPortfolioID, nil
+	}
+	if !portfolioIDPattern.MatchString(id) {
+		return "", fmt.Errorf("invalid portfolio id %q, expected up to 64 letters, digits, dashes or underscores", id)
+	}
+	return id, nil
+}
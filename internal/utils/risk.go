@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/stat"
+)
+
+// CovarianceMatrix returns the sample covariance matrix of the return series, which must all have
+// the same length.
+func CovarianceMatrix(returns [][]float64) [][]float64 {
+	n := len(returns)
+	cov := make([][]float64, n)
+	for i := range cov {
+		cov[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			c := stat.Covariance(returns[i], returns[j], nil)
+			cov[i][j] = c
+			cov[j][i] = c
+		}
+	}
+	return cov
+}
+
+// RiskParityWeights returns long-only weights summing to one under which every asset contributes
+// the same share of portfolio variance. It starts from inverse volatility weights and applies the
+// multiplicative update w_i *= sqrt(target / contribution_i) until the contributions settle.
+// Assets with zero variance get no weight.
+func RiskParityWeights(cov [][]float64) []float64 {
+	n := len(cov)
+	weights := make([]float64, n)
+	active := 0
+	for i := range weights {
+		if cov[i][i] > 0 {
+			weights[i] = 1 / math.Sqrt(cov[i][i])
+			active++
+		}
+	}
+	if active == 0 {
+		return weights
+	}
+	normalizeWeights(weights)
+
+	target := 1 / float64(active)
+	for iter := 0; iter < 1000; iter++ {
+		marginal := make([]float64, n)
+		variance := 0.0
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				marginal[i] += cov[i][j] * weights[j]
+			}
+			variance += weights[i] * marginal[i]
+		}
+		if variance <= 0 {
+			break
+		}
+
+		maxChange := 0.0
+		for i := range weights {
+			contribution := weights[i] * marginal[i] / variance
+			if weights[i] == 0 || contribution <= 0 {
+				continue
+			}
+			updated := weights[i] * math.Sqrt(target/contribution)
+			maxChange = math.Max(maxChange, math.Abs(updated-weights[i]))
+			weights[i] = updated
+		}
+		normalizeWeights(weights)
+		if maxChange < 1e-10 {
+			break
+		}
+	}
+	return weights
+}
+
+func normalizeWeights(weights []float64) {
+	total := 0.0
+	for _, w := range weights {
+		total += w
+	}
+	if total == 0 {
+		return
+	}
+	for i := range weights {
+		weights[i] /= total
+	}
+}
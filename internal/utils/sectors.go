@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	datapb "momentum-trading-platform/api/proto/data_service"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// sectorCacheTTL bounds how long sectors are kept in memory; the data service stores them for longer.
+const sectorCacheTTL = 24 * time.Hour
+
+type cachedSector struct {
+	sector    string
+	fetchedAt time.Time
+}
+
+// SectorCache reads sectors from the data service and caches them in memory. It is shared by the
+// services that classify symbols by sector.
+type SectorCache struct {
+	client datapb.DataServiceClient
+	mu     sync.Mutex
+	cache  map[string]cachedSector
+}
+
+func NewSectorCache(client datapb.DataServiceClient) *SectorCache {
+	return &SectorCache{
+		client: client,
+		cache:  make(map[string]cachedSector),
+	}
+}
+
+func (c *SectorCache) Sectors(symbols []string) (map[string]string, error) {
+	sectors := make(map[string]string, len(symbols))
+	var missing []string
+
+	c.mu.Lock()
+	for _, symbol := range symbols {
+		if cached, ok := c.cache[symbol]; ok && time.Since(cached.fetchedAt) < sectorCacheTTL {
+			if cached.sector != "" {
+				sectors[symbol] = cached.sector
+			}
+			continue
+		}
+		missing = append(missing, symbol)
+	}
+	c.mu.Unlock()
+
+	if len(missing) == 0 {
+		return sectors, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	resp, err := c.client.GetSecurityMetadata(ctx, &datapb.SecurityMetadataRequest{Symbols: missing})
+	if err != nil {
+		return sectors, err
+	}
+	for symbol, reason := range resp.Errors {
+		log.WithField("symbol", symbol).Warnf("⚠️ No security metadata: %s", reason)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for symbol, metadata := range resp.Metadata {
+		c.cache[symbol] = cachedSector{sector: metadata.Sector, fetchedAt: now}
+		if metadata.Sector != "" {
+			sectors[symbol] = metadata.Sector
+		}
+	}
+	return sectors, nil
+}